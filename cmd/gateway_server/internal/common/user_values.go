@@ -0,0 +1,15 @@
+package common
+
+// GatewayEndpointIdUserValue is the request user value holding the id of the gateway endpoint a relay is attributed to.
+const GatewayEndpointIdUserValue = "gateway_endpoint_id"
+
+// RelayCallerIdUserValue is the request user value holding the identifier relay usage is metered by, which is the gateway
+// endpoint id or the hash of the api key.
+const RelayCallerIdUserValue = "relay_caller_id"
+
+// AdminScopesUserValue is the request user value holding the []string of scopes granted to the admin request's x-api-key.
+const AdminScopesUserValue = "admin_scopes"
+
+// RelayLimitUserValue is the request user value holding a func() (relay_rate_limiter.RelayLimitResult, time.Duration)
+// that consumes a relay of the caller's api key, used by websockets to limit every request of a connection.
+const RelayLimitUserValue = "relay_limit"
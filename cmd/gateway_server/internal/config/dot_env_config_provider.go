@@ -3,10 +3,10 @@ package config
 import (
 	"fmt"
 	"github.com/joho/godotenv"
-	"github.com/pokt-network/gateway-server/internal/chain_network"
 	"github.com/pokt-network/gateway-server/internal/global_config"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -16,7 +16,6 @@ const (
 
 // Environment variable names
 const (
-	chainNetworkEnv                  = "CHAIN_NETWORK"
 	emitServiceUrlPromMetricsEnv     = "EMIT_SERVICE_URL_PROM_METRICS"
 	poktRPCFullHostEnv               = "POKT_RPC_FULL_HOST"
 	httpServerPortEnv                = "HTTP_SERVER_PORT"
@@ -27,12 +26,13 @@ const (
 	environmentStageEnv              = "ENVIRONMENT_STAGE"
 	poktApplicationsEncryptionKeyEnv = "POKT_APPLICATIONS_ENCRYPTION_KEY"
 	apiKey                           = "API_KEY"
+	relayForwardedHeadersEnv         = "RELAY_FORWARDED_HEADERS"
+	relayAPIKeyRequiredEnv           = "RELAY_API_KEY_REQUIRED"
 )
 
 // DotEnvGlobalConfigProvider implements the GatewayServerProvider interface.
 type DotEnvGlobalConfigProvider struct {
 	poktRPCFullHost               string
-	chainNetwork                  chain_network.ChainNetwork
 	httpServerPort                uint
 	poktRPCRequestTimeout         time.Duration
 	sessionCacheTTL               time.Duration
@@ -42,6 +42,8 @@ type DotEnvGlobalConfigProvider struct {
 	apiKey                        string
 	emitServiceUrlPromMetrics     bool
 	altruistRequestTimeout        time.Duration
+	relayForwardedHeaders         []string
+	relayAPIKeyRequired           bool
 }
 
 func (c DotEnvGlobalConfigProvider) GetAPIKey() string {
@@ -93,9 +95,14 @@ func (c DotEnvGlobalConfigProvider) ShouldEmitServiceUrlPromMetrics() bool {
 	return c.emitServiceUrlPromMetrics
 }
 
-// GetChainNetwork returns the current network, this can be useful for identifying the correct chain ids dependent on testnet or mainnet.
-func (c DotEnvGlobalConfigProvider) GetChainNetwork() chain_network.ChainNetwork {
-	return c.chainNetwork
+// GetRelayForwardedHeaders returns the client request headers that are forwarded to nodes and altruists.
+func (c DotEnvGlobalConfigProvider) GetRelayForwardedHeaders() []string {
+	return c.relayForwardedHeaders
+}
+
+// IsRelayAPIKeyRequired returns whether relays without an api key from relay_rate_limits or gateway_endpoints are rejected.
+func (c DotEnvGlobalConfigProvider) IsRelayAPIKeyRequired() bool {
+	return c.relayAPIKeyRequired
 }
 
 // NewDotEnvConfigProvider creates a new instance of DotEnvGlobalConfigProvider.
@@ -129,6 +136,20 @@ func NewDotEnvConfigProvider() *DotEnvGlobalConfigProvider {
 		emitServiceUrlPromMetrics = false
 	}
 
+	relayAPIKeyRequired, err := strconv.ParseBool(getEnvVar(relayAPIKeyRequiredEnv, "false"))
+
+	if err != nil {
+		relayAPIKeyRequired = false
+	}
+
+	// Optional, no client headers are forwarded by default
+	var relayForwardedHeaders []string
+	for _, header := range strings.Split(os.Getenv(relayForwardedHeadersEnv), ",") {
+		if header = strings.TrimSpace(header); header != "" {
+			relayForwardedHeaders = append(relayForwardedHeaders, header)
+		}
+	}
+
 	return &DotEnvGlobalConfigProvider{
 		emitServiceUrlPromMetrics:     emitServiceUrlPromMetrics,
 		poktRPCFullHost:               getEnvVar(poktRPCFullHostEnv, ""),
@@ -139,8 +160,9 @@ func NewDotEnvConfigProvider() *DotEnvGlobalConfigProvider {
 		environmentStage:              global_config.EnvironmentStage(getEnvVar(environmentStageEnv, "")),
 		poktApplicationsEncryptionKey: getEnvVar(poktApplicationsEncryptionKeyEnv, ""),
 		apiKey:                        getEnvVar(apiKey, ""),
-		chainNetwork:                  chain_network.ChainNetwork(getEnvVar(chainNetworkEnv, string(chain_network.MorseMainnet))),
 		altruistRequestTimeout:        altruistRequestTimeoutDuration,
+		relayForwardedHeaders:         relayForwardedHeaders,
+		relayAPIKeyRequired:           relayAPIKeyRequired,
 	}
 }
 
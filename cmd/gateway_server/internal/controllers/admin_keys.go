@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/transform"
+	"github.com/pokt-network/gateway-server/internal/admin_keys_registry"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	pkg_common "github.com/pokt-network/gateway-server/pkg/common"
+	"github.com/pquerna/ffjson/ffjson"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+	"slices"
+	"strconv"
+)
+
+const (
+	defaultAuditLogsLimit = 100
+	maxAuditLogsLimit     = 1000
+)
+
+type addAdminAPIKeyBody struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// AdminKeysController handles requests for scoped admin api keys and their audit logs
+type AdminKeysController struct {
+	logger            *zap.Logger
+	query             db_query.Querier
+	adminKeysRegistry admin_keys_registry.AdminKeysService
+}
+
+// NewAdminKeysController creates a new instance of AdminKeysController.
+func NewAdminKeysController(adminKeysRegistry admin_keys_registry.AdminKeysService, query db_query.Querier, logger *zap.Logger) *AdminKeysController {
+	return &AdminKeysController{adminKeysRegistry: adminKeysRegistry, query: query, logger: logger}
+}
+
+// GetAll returns all the admin api keys, without the keys themselves
+func (c *AdminKeysController) GetAll(ctx *fasthttp.RequestCtx) {
+	adminAPIKeys, err := c.query.GetAdminAPIKeys(context.Background())
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	adminAPIKeysPublic := []*models.PublicAdminAPIKey{}
+	for _, adminAPIKey := range adminAPIKeys {
+		adminAPIKeysPublic = append(adminAPIKeysPublic, transform.ToAdminAPIKey(adminAPIKey))
+	}
+	common.JSONSuccess(ctx, adminAPIKeysPublic, fasthttp.StatusOK)
+}
+
+// AddKey - creates an admin api key with the requested scopes, which must be granted to the caller's key so that keys
+// cannot escalate their own scopes. The generated api key is only returned in this response.
+func (c *AdminKeysController) AddKey(ctx *fasthttp.RequestCtx) {
+	var body addAdminAPIKeyBody
+	err := ffjson.Unmarshal(ctx.PostBody(), &body)
+	if err != nil {
+		common.JSONError(ctx, "Failed to unmarshal req", fasthttp.StatusBadRequest, err)
+		return
+	}
+	if body.Name == "" {
+		common.JSONError(ctx, "Missing name", fasthttp.StatusBadRequest, nil)
+		return
+	}
+	if len(body.Scopes) == 0 {
+		common.JSONError(ctx, "Missing scopes", fasthttp.StatusBadRequest, nil)
+		return
+	}
+	callerScopes, _ := ctx.UserValue(common.AdminScopesUserValue).([]string)
+	for _, scope := range body.Scopes {
+		if !admin_keys_registry.IsValidScope(scope) {
+			common.JSONError(ctx, fmt.Sprintf("Invalid scope %s", scope), fasthttp.StatusBadRequest, nil)
+			return
+		}
+		if !slices.Contains(callerScopes, scope) {
+			common.JSONError(ctx, fmt.Sprintf("Forbidden, x-api-key cannot grant the %s scope it is missing", scope), fasthttp.StatusForbidden, nil)
+			return
+		}
+	}
+
+	apiKey, err := generateAPIKey()
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	id, err := c.query.InsertAdminAPIKey(context.Background(), db_query.InsertAdminAPIKeyParams{
+		Name:       body.Name,
+		ApiKeyHash: pkg_common.Sha256HashHex(apiKey),
+		Scopes:     body.Scopes,
+	})
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	c.refreshAdminKeys()
+	adminAPIKeyId, _ := id.Value()
+	common.JSONSuccess(ctx, &models.PublicAdminAPIKey{
+		ID:     adminAPIKeyId.(string),
+		Name:   body.Name,
+		Scopes: body.Scopes,
+		APIKey: apiKey,
+	}, fasthttp.StatusCreated)
+}
+
+// DeleteKey - deletes an admin api key, which is rejected right away.
+func (c *AdminKeysController) DeleteKey(ctx *fasthttp.RequestCtx) {
+	uuid := pgtype.UUID{}
+	if err := uuid.Set(ctx.UserValue("key_id")); err != nil {
+		common.JSONError(ctx, "Invalid key id", fasthttp.StatusBadRequest, err)
+		return
+	}
+	_, err := c.query.DeleteAdminAPIKey(context.Background(), uuid)
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	c.refreshAdminKeys()
+	ctx.SetStatusCode(fasthttp.StatusOK)
+}
+
+// GetAuditLogs returns the most recent admin requests, newest first
+func (c *AdminKeysController) GetAuditLogs(ctx *fasthttp.RequestCtx) {
+	limit := defaultAuditLogsLimit
+	if rawLimit := string(ctx.QueryArgs().Peek("limit")); rawLimit != "" {
+		parsedLimit, err := strconv.Atoi(rawLimit)
+		if err != nil || parsedLimit <= 0 {
+			common.JSONError(ctx, "Invalid limit", fasthttp.StatusBadRequest, err)
+			return
+		}
+		limit = min(parsedLimit, maxAuditLogsLimit)
+	}
+	auditLogs, err := c.query.GetAdminAuditLogs(context.Background(), limit)
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	auditLogsPublic := []*models.PublicAdminAuditLog{}
+	for _, auditLog := range auditLogs {
+		auditLogsPublic = append(auditLogsPublic, transform.ToAdminAuditLog(auditLog))
+	}
+	common.JSONSuccess(ctx, auditLogsPublic, fasthttp.StatusOK)
+}
+
+// refreshAdminKeys - applies changes to admin requests right away, otherwise they apply on the next periodic update
+func (c *AdminKeysController) refreshAdminKeys() {
+	if err := c.adminKeysRegistry.Refresh(); err != nil {
+		c.logger.Sugar().Warnw("failed to refresh admin keys registry", "err", err)
+	}
+}
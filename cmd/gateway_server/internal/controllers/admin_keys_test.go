@@ -0,0 +1,26 @@
+package controllers
+
+import (
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/internal/admin_keys_registry"
+	db_query_mock "github.com/pokt-network/gateway-server/mocks/db_query"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestAddKeyRejectsScopesMissingFromCaller(t *testing.T) {
+	mockQuerier := new(db_query_mock.Querier)
+	controller := NewAdminKeysController(nil, mockQuerier, zap.NewNop())
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Request.SetBodyString(`{"name":"dashboard","scopes":["usage:read","chains:write"]}`)
+	ctx.SetUserValue(common.AdminScopesUserValue, []string{admin_keys_registry.ScopeAdminWrite, admin_keys_registry.ScopeUsageRead})
+	controller.AddKey(ctx)
+
+	// A key with admin:write can only create keys with scopes it holds itself
+	assert.Equal(t, fasthttp.StatusForbidden, ctx.Response.StatusCode())
+	assert.Contains(t, string(ctx.Response.Body()), admin_keys_registry.ScopeChainsWrite)
+	mockQuerier.AssertNotCalled(t, "InsertAdminAPIKey")
+}
@@ -0,0 +1,292 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/transform"
+	"github.com/pokt-network/gateway-server/internal/altruist_registry"
+	"github.com/pokt-network/gateway-server/internal/chain_configurations_registry"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/evm_chain_id_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/selection_strategy"
+	"github.com/pokt-network/gateway-server/internal/relayer"
+	"github.com/pquerna/ffjson/ffjson"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+	"net/url"
+	"time"
+)
+
+// ChainConfigurationsController handles requests to manage the configuration of chains
+type ChainConfigurationsController struct {
+	logger                     *zap.Logger
+	query                      db_query.Querier
+	chainConfigurationRegistry chain_configurations_registry.ChainConfigurationsService
+	altruistRegistry           altruist_registry.AltruistRegistryService
+}
+
+// NewChainConfigurationsController creates a new instance of ChainConfigurationsController.
+func NewChainConfigurationsController(chainConfigurationRegistry chain_configurations_registry.ChainConfigurationsService, altruistRegistry altruist_registry.AltruistRegistryService, query db_query.Querier, logger *zap.Logger) *ChainConfigurationsController {
+	return &ChainConfigurationsController{chainConfigurationRegistry: chainConfigurationRegistry, altruistRegistry: altruistRegistry, query: query, logger: logger}
+}
+
+// GetAll returns the configuration of all chains
+func (c *ChainConfigurationsController) GetAll(ctx *fasthttp.RequestCtx) {
+	chainConfigs, err := c.query.GetChainConfigurations(context.Background())
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	chainConfigsPublic := []*models.PublicChainConfiguration{}
+	for _, chainConfig := range chainConfigs {
+		chainConfigsPublic = append(chainConfigsPublic, transform.ToChainConfiguration(chainConfig))
+	}
+	common.JSONSuccess(ctx, chainConfigsPublic, fasthttp.StatusOK)
+}
+
+// AddChainConfiguration - adds the configuration of a chain that is not configured yet.
+func (c *ChainConfigurationsController) AddChainConfiguration(ctx *fasthttp.RequestCtx) {
+	var body models.PublicChainConfiguration
+	err := ffjson.Unmarshal(ctx.PostBody(), &body)
+	if err != nil {
+		common.JSONError(ctx, "Failed to unmarshal req", fasthttp.StatusBadRequest, err)
+		return
+	}
+	relayCacheTtls, err := validateChainConfiguration(&body)
+	if err != nil {
+		common.JSONError(ctx, err.Error(), fasthttp.StatusBadRequest, err)
+		return
+	}
+	_, ok, err := c.getChainConfiguration(body.ChainID)
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	if ok {
+		common.JSONError(ctx, "Chain configuration already exists", fasthttp.StatusConflict, nil)
+		return
+	}
+
+	id, err := c.query.InsertChainConfiguration(context.Background(), db_query.InsertChainConfigurationParams{
+		ChainID:                          body.ChainID,
+		PocketRequestTimeoutDuration:     body.PocketRequestTimeoutDuration,
+		AltruistUrl:                      body.AltruistUrl,
+		AltruistRequestTimeoutDuration:   body.AltruistRequestTimeoutDuration,
+		TopBucketP90latencyDuration:      body.TopBucketP90latencyDuration,
+		HeightCheckBlockTolerance:        body.HeightCheckBlockTolerance,
+		DataIntegrityCheckLookbackHeight: body.DataIntegrityCheckLookbackHeight,
+		NodeSelectionStrategy:            body.NodeSelectionStrategy,
+		RelayMaxAttempts:                 body.RelayMaxAttempts,
+		RelayRetryDeadlineDuration:       body.RelayRetryDeadlineDuration,
+		RelayHedgeDelayDuration:          body.RelayHedgeDelayDuration,
+		BatchRelayMode:                   body.BatchRelayMode,
+		AltruistWebsocketUrl:             body.AltruistWebsocketUrl,
+		RelayCacheTtls:                   relayCacheTtls,
+		RelayValidationSampleRate:        body.RelayValidationSampleRate,
+		EvmChainID:                       body.EvmChainID,
+		ArchivalCheckBlockHeight:         body.ArchivalCheckBlockHeight,
+		ChainFamily:                      body.ChainFamily,
+	})
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	c.refreshChainConfigurations()
+	chainConfigId, _ := id.Value()
+	body.ID = chainConfigId.(string)
+	common.JSONSuccess(ctx, &body, fasthttp.StatusCreated)
+}
+
+// UpdateChainConfiguration - replaces the configuration of a chain, omitted optional settings are unset.
+func (c *ChainConfigurationsController) UpdateChainConfiguration(ctx *fasthttp.RequestCtx) {
+	var body models.PublicChainConfiguration
+	err := ffjson.Unmarshal(ctx.PostBody(), &body)
+	if err != nil {
+		common.JSONError(ctx, "Failed to unmarshal req", fasthttp.StatusBadRequest, err)
+		return
+	}
+	chainId, ok := ctx.UserValue("chain_id").(string)
+	if !ok || (body.ChainID != "" && body.ChainID != chainId) {
+		common.JSONError(ctx, "Chain id of the path and body do not match", fasthttp.StatusBadRequest, nil)
+		return
+	}
+	body.ChainID = chainId
+	relayCacheTtls, err := validateChainConfiguration(&body)
+	if err != nil {
+		common.JSONError(ctx, err.Error(), fasthttp.StatusBadRequest, err)
+		return
+	}
+
+	cmdTag, err := c.query.UpdateChainConfiguration(context.Background(), db_query.UpdateChainConfigurationParams{
+		PocketRequestTimeoutDuration:     body.PocketRequestTimeoutDuration,
+		AltruistUrl:                      body.AltruistUrl,
+		AltruistRequestTimeoutDuration:   body.AltruistRequestTimeoutDuration,
+		TopBucketP90latencyDuration:      body.TopBucketP90latencyDuration,
+		HeightCheckBlockTolerance:        body.HeightCheckBlockTolerance,
+		DataIntegrityCheckLookbackHeight: body.DataIntegrityCheckLookbackHeight,
+		NodeSelectionStrategy:            body.NodeSelectionStrategy,
+		RelayMaxAttempts:                 body.RelayMaxAttempts,
+		RelayRetryDeadlineDuration:       body.RelayRetryDeadlineDuration,
+		RelayHedgeDelayDuration:          body.RelayHedgeDelayDuration,
+		BatchRelayMode:                   body.BatchRelayMode,
+		AltruistWebsocketUrl:             body.AltruistWebsocketUrl,
+		RelayCacheTtls:                   relayCacheTtls,
+		RelayValidationSampleRate:        body.RelayValidationSampleRate,
+		EvmChainID:                       body.EvmChainID,
+		ArchivalCheckBlockHeight:         body.ArchivalCheckBlockHeight,
+		ChainFamily:                      body.ChainFamily,
+		ChainID:                          body.ChainID,
+	})
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	if cmdTag.RowsAffected() == 0 {
+		common.JSONError(ctx, "Chain configuration not found", fasthttp.StatusNotFound, nil)
+		return
+	}
+	c.refreshChainConfigurations()
+	chainConfig, _, err := c.getChainConfiguration(body.ChainID)
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	common.JSONSuccess(ctx, transform.ToChainConfiguration(chainConfig), fasthttp.StatusOK)
+}
+
+// DeleteChainConfiguration - deletes the configuration of a chain, its relays are only sent to nodes afterward.
+func (c *ChainConfigurationsController) DeleteChainConfiguration(ctx *fasthttp.RequestCtx) {
+	chainId, _ := ctx.UserValue("chain_id").(string)
+	cmdTag, err := c.query.DeleteChainConfiguration(context.Background(), chainId)
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	if cmdTag.RowsAffected() == 0 {
+		common.JSONError(ctx, "Chain configuration not found", fasthttp.StatusNotFound, nil)
+		return
+	}
+	c.refreshChainConfigurations()
+	ctx.SetStatusCode(fasthttp.StatusOK)
+}
+
+func (c *ChainConfigurationsController) getChainConfiguration(chainId string) (db_query.GetChainConfigurationsRow, bool, error) {
+	chainConfigs, err := c.query.GetChainConfigurations(context.Background())
+	if err != nil {
+		return db_query.GetChainConfigurationsRow{}, false, err
+	}
+	for _, chainConfig := range chainConfigs {
+		if chainConfig.ChainID.String == chainId {
+			return chainConfig, true, nil
+		}
+	}
+	return db_query.GetChainConfigurationsRow{}, false, nil
+}
+
+// refreshChainConfigurations - applies changes to relays right away, otherwise they apply on the next periodic update
+func (c *ChainConfigurationsController) refreshChainConfigurations() {
+	if err := c.chainConfigurationRegistry.Refresh(); err != nil {
+		c.logger.Sugar().Warnw("failed to refresh chain configurations registry", "err", err)
+	}
+	// The altruist url of a chain configuration is used by chains without altruists in chain_altruists
+	if err := c.altruistRegistry.Refresh(); err != nil {
+		c.logger.Sugar().Warnw("failed to refresh altruist registry", "err", err)
+	}
+}
+
+// validateChainConfiguration - validates the settings the same way the gateway parses them, so that a chain cannot be
+// misconfigured through the api. Returns the relay cache ttls as stored in the database.
+func validateChainConfiguration(chainConfig *models.PublicChainConfiguration) (string, error) {
+	if chainConfig.ChainID == "" {
+		return "", errors.New("Missing chain_id")
+	}
+	requiredDurations := map[string]string{
+		"pocket_request_timeout_duration":   chainConfig.PocketRequestTimeoutDuration,
+		"altruist_request_timeout_duration": chainConfig.AltruistRequestTimeoutDuration,
+		"top_bucket_p90latency_duration":    chainConfig.TopBucketP90latencyDuration,
+	}
+	for field, duration := range requiredDurations {
+		if err := validateDuration(field, duration); err != nil {
+			return "", err
+		}
+	}
+	if chainConfig.RelayRetryDeadlineDuration != "" {
+		if err := validateDuration("relay_retry_deadline_duration", chainConfig.RelayRetryDeadlineDuration); err != nil {
+			return "", err
+		}
+	}
+	if chainConfig.RelayHedgeDelayDuration != "" && chainConfig.RelayHedgeDelayDuration != relayer.HedgeDelayChainP50 {
+		if err := validateDuration("relay_hedge_delay_duration", chainConfig.RelayHedgeDelayDuration); err != nil {
+			return "", err
+		}
+	}
+	if err := validateUrl("altruist_url", chainConfig.AltruistUrl, "http", "https"); err != nil {
+		return "", err
+	}
+	if chainConfig.AltruistWebsocketUrl != "" {
+		if err := validateUrl("altruist_websocket_url", chainConfig.AltruistWebsocketUrl, "ws", "wss"); err != nil {
+			return "", err
+		}
+	}
+	if chainConfig.HeightCheckBlockTolerance < 0 || chainConfig.DataIntegrityCheckLookbackHeight < 0 || chainConfig.RelayMaxAttempts < 0 || chainConfig.ArchivalCheckBlockHeight < 0 {
+		return "", errors.New("Block heights and attempts cannot be negative")
+	}
+	if chainConfig.NodeSelectionStrategy != "" {
+		if _, ok := selection_strategy.NewSelectionStrategies()[chainConfig.NodeSelectionStrategy]; !ok {
+			return "", fmt.Errorf("Unknown node_selection_strategy %s", chainConfig.NodeSelectionStrategy)
+		}
+	}
+	if chainConfig.BatchRelayMode != "" && chainConfig.BatchRelayMode != relayer.BatchRelayModePassthrough && chainConfig.BatchRelayMode != relayer.BatchRelayModeSplit {
+		return "", fmt.Errorf("Unknown batch_relay_mode %s", chainConfig.BatchRelayMode)
+	}
+	if chainConfig.RelayValidationSampleRate < 0 || chainConfig.RelayValidationSampleRate > 1 {
+		return "", errors.New("Invalid relay_validation_sample_rate, expected a rate between 0 and 1")
+	}
+	if chainConfig.EvmChainID != "" {
+		if _, err := evm_chain_id_check.ParseChainId(chainConfig.EvmChainID); err != nil {
+			return "", errors.New("Invalid evm_chain_id, expected a decimal or 0x prefixed hex chain id")
+		}
+	}
+	if chainConfig.ChainFamily != "" && !checks.IsKnownChainFamily(chainConfig.ChainFamily) {
+		return "", fmt.Errorf("Unknown chain_family %s", chainConfig.ChainFamily)
+	}
+	if len(chainConfig.RelayCacheTtls) == 0 {
+		return "", nil
+	}
+	for method, ttl := range chainConfig.RelayCacheTtls {
+		if err := validateDuration("relay_cache_ttls."+method, ttl); err != nil {
+			return "", err
+		}
+	}
+	relayCacheTtls, err := json.Marshal(chainConfig.RelayCacheTtls)
+	if err != nil {
+		return "", err
+	}
+	return string(relayCacheTtls), nil
+}
+
+func validateDuration(field string, rawDuration string) error {
+	duration, err := time.ParseDuration(rawDuration)
+	if err != nil || duration <= 0 {
+		return fmt.Errorf("Invalid %s, expected a positive duration such as 10s", field)
+	}
+	return nil
+}
+
+func validateUrl(field string, rawUrl string, schemes ...string) error {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil || parsedUrl.Host == "" {
+		return fmt.Errorf("Invalid %s", field)
+	}
+	for _, scheme := range schemes {
+		if parsedUrl.Scheme == scheme {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid %s, expected a %s url", field, schemes[0])
+}
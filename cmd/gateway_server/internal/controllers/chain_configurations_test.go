@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func validChainConfiguration() models.PublicChainConfiguration {
+	return models.PublicChainConfiguration{
+		ChainID:                          "0021",
+		PocketRequestTimeoutDuration:     "15s",
+		AltruistUrl:                      "https://altruist.example.com",
+		AltruistRequestTimeoutDuration:   "30s",
+		TopBucketP90latencyDuration:      "150ms",
+		HeightCheckBlockTolerance:        100,
+		DataIntegrityCheckLookbackHeight: 25,
+	}
+}
+
+func TestValidateChainConfiguration(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(chainConfig *models.PublicChainConfiguration)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(chainConfig *models.PublicChainConfiguration) {}},
+		{name: "missing chain id", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.ChainID = "" }, wantErr: true},
+		{name: "invalid duration", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.PocketRequestTimeoutDuration = "15" }, wantErr: true},
+		{name: "negative duration", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.TopBucketP90latencyDuration = "-1s" }, wantErr: true},
+		{name: "altruist url without scheme", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.AltruistUrl = "example.com" }, wantErr: true},
+		{name: "websocket url with http scheme", modify: func(chainConfig *models.PublicChainConfiguration) {
+			chainConfig.AltruistWebsocketUrl = "https://altruist.example.com"
+		}, wantErr: true},
+		{name: "p50 hedge delay", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.RelayHedgeDelayDuration = "p50" }},
+		{name: "unknown node selection strategy", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.NodeSelectionStrategy = "fastest" }, wantErr: true},
+		{name: "unknown batch relay mode", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.BatchRelayMode = "merge" }, wantErr: true},
+		{name: "relay validation sample rate above 1", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.RelayValidationSampleRate = 1.5 }, wantErr: true},
+		{name: "hex evm chain id", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.EvmChainID = "0x89" }},
+		{name: "cosmos chain family", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.ChainFamily = "cosmos_lcd" }},
+		{name: "unknown chain family", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.ChainFamily = "polkadot" }, wantErr: true},
+		{name: "invalid evm chain id", modify: func(chainConfig *models.PublicChainConfiguration) { chainConfig.EvmChainID = "polygon" }, wantErr: true},
+		{name: "invalid relay cache ttl", modify: func(chainConfig *models.PublicChainConfiguration) {
+			chainConfig.RelayCacheTtls = map[string]string{"eth_chainId": "forever"}
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chainConfig := validChainConfiguration()
+			tt.modify(&chainConfig)
+			_, err := validateChainConfiguration(&chainConfig)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestValidateChainConfigurationRelayCacheTtls(t *testing.T) {
+	chainConfig := validChainConfiguration()
+	relayCacheTtls, err := validateChainConfiguration(&chainConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, "", relayCacheTtls)
+
+	chainConfig.RelayCacheTtls = map[string]string{"eth_chainId": "1h"}
+	relayCacheTtls, err = validateChainConfiguration(&chainConfig)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"eth_chainId":"1h"}`, relayCacheTtls)
+}
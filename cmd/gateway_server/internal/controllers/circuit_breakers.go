@@ -0,0 +1,30 @@
+package controllers
+
+import (
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/transform"
+	"github.com/pokt-network/gateway-server/internal/altruist_circuit_breaker"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+)
+
+// CircuitBreakersController exposes the altruist circuit breaker of each chain
+type CircuitBreakersController struct {
+	logger         *zap.Logger
+	circuitBreaker altruist_circuit_breaker.CircuitBreakerService
+}
+
+// NewCircuitBreakersController creates a new instance of CircuitBreakersController.
+func NewCircuitBreakersController(circuitBreaker altruist_circuit_breaker.CircuitBreakerService, logger *zap.Logger) *CircuitBreakersController {
+	return &CircuitBreakersController{circuitBreaker: circuitBreaker, logger: logger}
+}
+
+// GetAll returns the circuit breaker of every chain that has sent relays to its altruists.
+func (c *CircuitBreakersController) GetAll(ctx *fasthttp.RequestCtx) {
+	circuitBreakers := []*models.PublicCircuitBreaker{}
+	for _, circuit := range c.circuitBreaker.GetCircuits() {
+		circuitBreakers = append(circuitBreakers, transform.ToPublicCircuitBreaker(circuit))
+	}
+	common.JSONSuccess(ctx, circuitBreakers, fasthttp.StatusOK)
+}
@@ -0,0 +1,253 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/transform"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/gateway_endpoints_registry"
+	pkg_common "github.com/pokt-network/gateway-server/pkg/common"
+	"github.com/pquerna/ffjson/ffjson"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+)
+
+// amount of random bytes of a generated api key
+const apiKeyLength = 32
+
+type addGatewayUserBody struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+type addGatewayEndpointBody struct {
+	UserID        string   `json:"user_id"`
+	Name          string   `json:"name"`
+	AllowedChains []string `json:"allowed_chains"`
+}
+
+// updateGatewayEndpointBody - omitted fields keep their current value
+type updateGatewayEndpointBody struct {
+	Name          *string   `json:"name"`
+	AllowedChains *[]string `json:"allowed_chains"`
+	Enabled       *bool     `json:"enabled"`
+}
+
+// GatewayEndpointsController handles requests for gateway users and the endpoints they relay with
+type GatewayEndpointsController struct {
+	logger                   *zap.Logger
+	query                    db_query.Querier
+	gatewayEndpointsRegistry gateway_endpoints_registry.GatewayEndpointsService
+}
+
+// NewGatewayEndpointsController creates a new instance of GatewayEndpointsController.
+func NewGatewayEndpointsController(gatewayEndpointsRegistry gateway_endpoints_registry.GatewayEndpointsService, query db_query.Querier, logger *zap.Logger) *GatewayEndpointsController {
+	return &GatewayEndpointsController{gatewayEndpointsRegistry: gatewayEndpointsRegistry, query: query, logger: logger}
+}
+
+// GetAllUsers returns all the gateway users
+func (c *GatewayEndpointsController) GetAllUsers(ctx *fasthttp.RequestCtx) {
+	users, err := c.query.GetGatewayUsers(context.Background())
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	usersPublic := []*models.PublicGatewayUser{}
+	for _, user := range users {
+		usersPublic = append(usersPublic, transform.ToGatewayUser(user))
+	}
+	common.JSONSuccess(ctx, usersPublic, fasthttp.StatusOK)
+}
+
+// AddUser - creates a gateway user, which endpoints are created for.
+func (c *GatewayEndpointsController) AddUser(ctx *fasthttp.RequestCtx) {
+	var body addGatewayUserBody
+	err := ffjson.Unmarshal(ctx.PostBody(), &body)
+	if err != nil {
+		common.JSONError(ctx, "Failed to unmarshal req", fasthttp.StatusBadRequest, err)
+		return
+	}
+	if body.Email == "" {
+		common.JSONError(ctx, "Missing email", fasthttp.StatusBadRequest, nil)
+		return
+	}
+	id, err := c.query.InsertGatewayUser(context.Background(), body.Email, body.Name)
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	userId, _ := id.Value()
+	common.JSONSuccess(ctx, &models.PublicGatewayUser{ID: userId.(string), Email: body.Email, Name: body.Name}, fasthttp.StatusCreated)
+}
+
+// DeleteUser - deletes a gateway user along with its endpoints.
+func (c *GatewayEndpointsController) DeleteUser(ctx *fasthttp.RequestCtx) {
+	uuid := pgtype.UUID{}
+	if err := uuid.Set(ctx.UserValue("user_id")); err != nil {
+		common.JSONError(ctx, "Invalid user id", fasthttp.StatusBadRequest, err)
+		return
+	}
+	_, err := c.query.DeleteGatewayUser(context.Background(), uuid)
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	c.refreshGatewayEndpoints()
+	ctx.SetStatusCode(fasthttp.StatusOK)
+}
+
+// GetAllEndpoints returns all the gateway endpoints, without their api keys
+func (c *GatewayEndpointsController) GetAllEndpoints(ctx *fasthttp.RequestCtx) {
+	endpoints, err := c.query.GetGatewayEndpoints(context.Background())
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	endpointsPublic := []*models.PublicGatewayEndpoint{}
+	for _, endpoint := range endpoints {
+		endpointsPublic = append(endpointsPublic, transform.ToGatewayEndpoint(endpoint))
+	}
+	common.JSONSuccess(ctx, endpointsPublic, fasthttp.StatusOK)
+}
+
+// AddEndpoint - creates an enabled endpoint for a gateway user. The generated api key is only returned in this response.
+func (c *GatewayEndpointsController) AddEndpoint(ctx *fasthttp.RequestCtx) {
+	var body addGatewayEndpointBody
+	err := ffjson.Unmarshal(ctx.PostBody(), &body)
+	if err != nil {
+		common.JSONError(ctx, "Failed to unmarshal req", fasthttp.StatusBadRequest, err)
+		return
+	}
+	userId := pgtype.UUID{}
+	if err := userId.Set(body.UserID); err != nil {
+		common.JSONError(ctx, "Invalid user id", fasthttp.StatusBadRequest, err)
+		return
+	}
+	if body.AllowedChains == nil {
+		body.AllowedChains = []string{}
+	}
+
+	apiKey, err := generateAPIKey()
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+
+	id, err := c.query.InsertGatewayEndpoint(context.Background(), db_query.InsertGatewayEndpointParams{
+		UserID:        userId,
+		Name:          body.Name,
+		ApiKeyHash:    pkg_common.Sha256HashHex(apiKey),
+		AllowedChains: body.AllowedChains,
+		Enabled:       true,
+	})
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	c.refreshGatewayEndpoints()
+	endpointId, _ := id.Value()
+	common.JSONSuccess(ctx, &models.PublicGatewayEndpoint{
+		ID:            endpointId.(string),
+		UserID:        body.UserID,
+		Name:          body.Name,
+		AllowedChains: body.AllowedChains,
+		Enabled:       true,
+		APIKey:        apiKey,
+	}, fasthttp.StatusCreated)
+}
+
+// UpdateEndpoint - updates the name, allowed chains or enabled flag of an endpoint.
+func (c *GatewayEndpointsController) UpdateEndpoint(ctx *fasthttp.RequestCtx) {
+	var body updateGatewayEndpointBody
+	err := ffjson.Unmarshal(ctx.PostBody(), &body)
+	if err != nil {
+		common.JSONError(ctx, "Failed to unmarshal req", fasthttp.StatusBadRequest, err)
+		return
+	}
+	uuid := pgtype.UUID{}
+	if err := uuid.Set(ctx.UserValue("endpoint_id")); err != nil {
+		common.JSONError(ctx, "Invalid endpoint id", fasthttp.StatusBadRequest, err)
+		return
+	}
+	endpoint, ok, err := c.getEndpoint(uuid)
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	if !ok {
+		common.JSONError(ctx, "Endpoint not found", fasthttp.StatusNotFound, nil)
+		return
+	}
+
+	endpointPublic := transform.ToGatewayEndpoint(endpoint)
+	if body.Name != nil {
+		endpointPublic.Name = *body.Name
+	}
+	if body.AllowedChains != nil && *body.AllowedChains != nil {
+		endpointPublic.AllowedChains = *body.AllowedChains
+	}
+	if body.Enabled != nil {
+		endpointPublic.Enabled = *body.Enabled
+	}
+	_, err = c.query.UpdateGatewayEndpoint(context.Background(), db_query.UpdateGatewayEndpointParams{
+		Name:          endpointPublic.Name,
+		AllowedChains: endpointPublic.AllowedChains,
+		Enabled:       endpointPublic.Enabled,
+		EndpointID:    endpoint.ID,
+	})
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	c.refreshGatewayEndpoints()
+	common.JSONSuccess(ctx, endpointPublic, fasthttp.StatusOK)
+}
+
+// DeleteEndpoint - deletes an endpoint, its api key can no longer be used to relay.
+func (c *GatewayEndpointsController) DeleteEndpoint(ctx *fasthttp.RequestCtx) {
+	uuid := pgtype.UUID{}
+	if err := uuid.Set(ctx.UserValue("endpoint_id")); err != nil {
+		common.JSONError(ctx, "Invalid endpoint id", fasthttp.StatusBadRequest, err)
+		return
+	}
+	_, err := c.query.DeleteGatewayEndpoint(context.Background(), uuid)
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	c.refreshGatewayEndpoints()
+	ctx.SetStatusCode(fasthttp.StatusOK)
+}
+
+func (c *GatewayEndpointsController) getEndpoint(endpointId pgtype.UUID) (db_query.GetGatewayEndpointsRow, bool, error) {
+	endpoints, err := c.query.GetGatewayEndpoints(context.Background())
+	if err != nil {
+		return db_query.GetGatewayEndpointsRow{}, false, err
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.ID.Bytes == endpointId.Bytes {
+			return endpoint, true, nil
+		}
+	}
+	return db_query.GetGatewayEndpointsRow{}, false, nil
+}
+
+// generateAPIKey - returns a random api key, of which only the hash is stored
+func generateAPIKey() (string, error) {
+	apiKeyBytes := make([]byte, apiKeyLength)
+	if _, err := rand.Read(apiKeyBytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(apiKeyBytes), nil
+}
+
+// refreshGatewayEndpoints - applies changes to relays right away, otherwise they apply on the next periodic update
+func (c *GatewayEndpointsController) refreshGatewayEndpoints() {
+	if err := c.gatewayEndpointsRegistry.Refresh(); err != nil {
+		c.logger.Sugar().Warnw("failed to refresh gateway endpoints registry", "err", err)
+	}
+}
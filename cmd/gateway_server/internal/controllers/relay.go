@@ -1,29 +1,55 @@
 package controllers
 
 import (
-	"fmt"
+	"errors"
 	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/internal/altruist_circuit_breaker"
+	"github.com/pokt-network/gateway-server/internal/global_config"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/relay_usage_meter"
 	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0"
 	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/valyala/fasthttp"
 	"go.uber.org/zap"
+	"strconv"
 	"strings"
 )
 
+var (
+	counterGatewayEndpointRelay *prometheus.CounterVec
+)
+
+func init() {
+	counterGatewayEndpointRelay = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gateway_endpoint_relay_counter",
+			Help: "Relays attributed to a gateway endpoint and if they succeeded",
+		},
+		[]string{"success", "endpoint_id"},
+	)
+	prometheus.MustRegister(counterGatewayEndpointRelay)
+}
+
 // RelayController handles relay requests for a specific chain.
 type RelayController struct {
-	logger  *zap.Logger
-	relayer pokt_v0.PocketRelayer
+	logger              *zap.Logger
+	relayer             pokt_v0.PocketRelayer
+	relayConfigProvider global_config.RelayConfigProvider
+	relayUsageMeter     relay_usage_meter.RelayUsageMeterService
 }
 
 // NewRelayController creates a new instance of RelayController.
-func NewRelayController(relayer pokt_v0.PocketRelayer, logger *zap.Logger) *RelayController {
-	return &RelayController{relayer: relayer, logger: logger}
+func NewRelayController(relayer pokt_v0.PocketRelayer, relayConfigProvider global_config.RelayConfigProvider, relayUsageMeter relay_usage_meter.RelayUsageMeterService, logger *zap.Logger) *RelayController {
+	return &RelayController{relayer: relayer, relayConfigProvider: relayConfigProvider, relayUsageMeter: relayUsageMeter, logger: logger}
 }
 
 // chainIdLength represents the expected length of chain IDs.
 const chainIdLength = 4
 
+// archivalHeader is set to true by clients whose relays need historical state.
+const archivalHeader = "X-Archival"
+
 // HandleRelay handles incoming relay requests.
 func (c *RelayController) HandleRelay(ctx *fasthttp.RequestCtx) {
 
@@ -35,28 +61,93 @@ func (c *RelayController) HandleRelay(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
+	// REST-style chains rely on query parameters
+	if queryString := ctx.QueryArgs().QueryString(); len(queryString) > 0 {
+		path = path + "?" + string(queryString)
+	}
+
 	relay, err := c.relayer.SendRelay(&models.SendRelayRequest{
 		Payload: &models.Payload{
-			Data:   string(ctx.PostBody()),
-			Method: string(ctx.Method()),
-			Path:   path,
+			Data:    string(ctx.PostBody()),
+			Method:  string(ctx.Method()),
+			Path:    path,
+			Headers: c.getForwardedHeaders(ctx),
 		},
-		Chain: chainID,
+		Chain:    chainID,
+		Archival: string(ctx.Request.Header.Peek(archivalHeader)) == "true",
 	})
 
+	endpointId, isAttributed := ctx.UserValue(common.GatewayEndpointIdUserValue).(string)
+	if isAttributed {
+		counterGatewayEndpointRelay.WithLabelValues(strconv.FormatBool(err == nil), endpointId).Inc()
+	}
+
+	// Anonymous relays are metered with an empty caller id
+	callerId, _ := ctx.UserValue(common.RelayCallerIdUserValue).(string)
+	usage := relay_usage_meter.RelayUsage{CallerId: callerId, ChainId: chainID, Success: err == nil, RequestBytes: len(ctx.PostBody())}
+	if err == nil {
+		usage.Altruist = relay.Altruist
+		usage.ResponseBytes = len(relay.Response)
+	}
+	c.relayUsageMeter.RecordRelay(usage)
+
 	if err != nil {
-		c.logger.Error("Error relaying", zap.Error(err))
-		common.JSONError(ctx, fmt.Sprintf("Something went wrong %v", err), fasthttp.StatusInternalServerError, err)
+		c.logger.Error("Error relaying", zap.Error(err), zap.String("endpoint_id", endpointId))
+		// Errors can contain node urls and internal details, so callers only receive the status
+		statusCode := getRelayErrorStatusCode(err)
+		common.JSONError(ctx, fasthttp.StatusMessage(statusCode), statusCode, nil)
 		return
 	}
 
-	// Send a successful response back to the client.
-	ctx.Response.SetStatusCode(fasthttp.StatusOK)
+	// Send the upstream response back to the client.
+	statusCode := relay.StatusCode
+	if statusCode == 0 {
+		statusCode = fasthttp.StatusOK
+	}
+	ctx.Response.SetStatusCode(statusCode)
 	ctx.Response.Header.Set("Content-Type", "application/json")
+	for header, value := range relay.Headers {
+		ctx.Response.Header.Set(header, value)
+	}
 	ctx.Response.SetBodyString(relay.Response)
 	return
 }
 
+// getForwardedHeaders: returns the allow-listed client request headers, or nil if the client did not send any
+func (c *RelayController) getForwardedHeaders(ctx *fasthttp.RequestCtx) map[string]string {
+	var headers map[string]string
+	for _, header := range c.relayConfigProvider.GetRelayForwardedHeaders() {
+		value := ctx.Request.Header.Peek(header)
+		if len(value) == 0 {
+			continue
+		}
+		if headers == nil {
+			headers = map[string]string{}
+		}
+		headers[header] = string(value)
+	}
+	return headers
+}
+
+// getRelayErrorStatusCode: maps a relay error to the status code returned to the client
+func getRelayErrorStatusCode(err error) int {
+	if errors.Is(err, models.ErrMalformedSendRelayRequest) {
+		return fasthttp.StatusInternalServerError
+	}
+	if errors.Is(err, models.ErrNoNodeAvailable) || errors.Is(err, altruist_circuit_breaker.ErrCircuitOpen) {
+		return fasthttp.StatusServiceUnavailable
+	}
+	if checks.IsTimeoutError(err) {
+		return fasthttp.StatusGatewayTimeout
+	}
+	// Client errors of the node that are not caused by the node, such as a malformed relay, are returned as-is
+	var pocketError models.PocketRPCError
+	if errors.As(err, &pocketError) && pocketError.HttpCode >= fasthttp.StatusBadRequest && pocketError.HttpCode < fasthttp.StatusInternalServerError && !checks.IsNodeError(err) {
+		return pocketError.HttpCode
+	}
+	return fasthttp.StatusBadGateway
+}
+
 // getPathSegmented: returns the chain being requested and other parts to be proxied to pokt nodes
 // Example: /relay/0001/v1/client, returns 0001, /v1/client
 func getPathSegmented(path []byte) (chain, otherParts string) {
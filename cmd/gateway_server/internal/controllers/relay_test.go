@@ -3,10 +3,17 @@ package controllers
 // Basic imports
 import (
 	"errors"
+	"fmt"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/internal/altruist_circuit_breaker"
+	"github.com/pokt-network/gateway-server/internal/relay_usage_meter"
+	global_config_mock "github.com/pokt-network/gateway-server/mocks/global_config"
 	pocket_service_mock "github.com/pokt-network/gateway-server/mocks/pocket_service"
+	relay_usage_meter_mock "github.com/pokt-network/gateway-server/mocks/relay_usage_meter"
 	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/valyala/fasthttp"
 	"go.uber.org/zap"
@@ -14,7 +21,9 @@ import (
 
 type RelayTestSuite struct {
 	suite.Suite
-	mockPocketService *pocket_service_mock.PocketService
+	mockPocketService  *pocket_service_mock.PocketService
+	mockConfigProvider *global_config_mock.GlobalConfigProvider
+	mockUsageMeter     *relay_usage_meter_mock.RelayUsageMeterService
 
 	mockRelayController *RelayController
 	context             *fasthttp.RequestCtx
@@ -22,7 +31,11 @@ type RelayTestSuite struct {
 
 func (suite *RelayTestSuite) SetupTest() {
 	suite.mockPocketService = new(pocket_service_mock.PocketService)
-	suite.mockRelayController = NewRelayController(suite.mockPocketService, zap.NewNop())
+	suite.mockConfigProvider = new(global_config_mock.GlobalConfigProvider)
+	suite.mockConfigProvider.EXPECT().GetRelayForwardedHeaders().Return([]string{"Authorization", "Accept"}).Maybe()
+	suite.mockUsageMeter = new(relay_usage_meter_mock.RelayUsageMeterService)
+	suite.mockUsageMeter.EXPECT().RecordRelay(mock.Anything).Maybe()
+	suite.mockRelayController = NewRelayController(suite.mockPocketService, suite.mockConfigProvider, suite.mockUsageMeter, zap.NewNop())
 	suite.context = &fasthttp.RequestCtx{} // mock the fasthttp.RequestCtx
 }
 
@@ -76,9 +89,104 @@ func (suite *RelayTestSuite) TestHandleRelay() {
 					Return(nil, errors.New("relay error"))
 			},
 			path:             "/relay/1234",
-			expectedStatus:   fasthttp.StatusInternalServerError,
+			expectedStatus:   fasthttp.StatusBadGateway,
 			expectedResponse: nil,
 		},
+		{
+			name: "NoNodeAvailable",
+			setupMocks: func(ctx *fasthttp.RequestCtx) {
+				suite.mockPocketService.EXPECT().SendRelay(suite.mockSendRelayRequest()).
+					Return(nil, models.ErrNoNodeAvailable)
+			},
+			path:             "/relay/1234",
+			expectedStatus:   fasthttp.StatusServiceUnavailable,
+			expectedResponse: nil,
+		},
+		{
+			name: "RelayTimeout",
+			setupMocks: func(ctx *fasthttp.RequestCtx) {
+				suite.mockPocketService.EXPECT().SendRelay(suite.mockSendRelayRequest()).
+					Return(nil, fasthttp.ErrTimeout)
+			},
+			path:             "/relay/1234",
+			expectedStatus:   fasthttp.StatusGatewayTimeout,
+			expectedResponse: nil,
+		},
+		{
+			name: "NodeClientError",
+			setupMocks: func(ctx *fasthttp.RequestCtx) {
+				suite.mockPocketService.EXPECT().SendRelay(suite.mockSendRelayRequest()).
+					Return(nil, models.PocketRPCError{HttpCode: fasthttp.StatusBadRequest, Message: "invalid payload"})
+			},
+			path:             "/relay/1234",
+			expectedStatus:   fasthttp.StatusBadRequest,
+			expectedResponse: nil,
+		},
+		{
+			name: "NodeServerError",
+			setupMocks: func(ctx *fasthttp.RequestCtx) {
+				suite.mockPocketService.EXPECT().SendRelay(suite.mockSendRelayRequest()).
+					Return(nil, models.PocketRPCError{HttpCode: fasthttp.StatusInternalServerError, Message: "internal error"})
+			},
+			path:             "/relay/1234",
+			expectedStatus:   fasthttp.StatusGatewayTimeout,
+			expectedResponse: nil,
+		},
+		{
+			name: "AltruistCircuitOpen",
+			setupMocks: func(ctx *fasthttp.RequestCtx) {
+				suite.mockPocketService.EXPECT().SendRelay(suite.mockSendRelayRequest()).
+					Return(nil, fmt.Errorf("%w: %w", altruist_circuit_breaker.ErrCircuitOpen, fasthttp.ErrTimeout))
+			},
+			path:             "/relay/1234",
+			expectedStatus:   fasthttp.StatusServiceUnavailable,
+			expectedResponse: nil,
+		},
+		{
+			name: "ForwardsAllowedHeaders",
+			setupMocks: func(ctx *fasthttp.RequestCtx) {
+				ctx.Request.Header.Set("Authorization", "Bearer token")
+				ctx.Request.Header.Set("Cookie", "session")
+				req := suite.mockSendRelayRequest()
+				req.Payload.Headers = map[string]string{"Authorization": "Bearer token"}
+				suite.mockPocketService.EXPECT().SendRelay(req).
+					Return(&models.SendRelayResponse{
+						Response: testResponse,
+					}, nil)
+			},
+			path:             "/relay/1234",
+			expectedStatus:   fasthttp.StatusOK,
+			expectedResponse: &testResponse,
+		},
+		{
+			name: "ArchivalRelay",
+			setupMocks: func(ctx *fasthttp.RequestCtx) {
+				ctx.Request.Header.Set("X-Archival", "true")
+				req := suite.mockSendRelayRequest()
+				req.Archival = true
+				suite.mockPocketService.EXPECT().SendRelay(req).
+					Return(&models.SendRelayResponse{
+						Response: testResponse,
+					}, nil)
+			},
+			path:             "/relay/1234",
+			expectedStatus:   fasthttp.StatusOK,
+			expectedResponse: &testResponse,
+		},
+		{
+			name: "UpstreamStatusAndHeaders",
+			setupMocks: func(ctx *fasthttp.RequestCtx) {
+				suite.mockPocketService.EXPECT().SendRelay(suite.mockSendRelayRequest()).
+					Return(&models.SendRelayResponse{
+						Response:   testResponse,
+						StatusCode: fasthttp.StatusTooManyRequests,
+						Headers:    map[string]string{"Retry-After": "10"},
+					}, nil)
+			},
+			path:             "/relay/1234",
+			expectedStatus:   fasthttp.StatusTooManyRequests,
+			expectedResponse: &testResponse,
+		},
 		{
 			name: "Success",
 			setupMocks: func(ctx *fasthttp.RequestCtx) {
@@ -116,6 +224,44 @@ func (suite *RelayTestSuite) TestHandleRelay() {
 	}
 }
 
+func (suite *RelayTestSuite) TestRelayErrorHidesErrorDetails() {
+	suite.context.Request.SetBody([]byte("test"))
+	suite.context.Request.Header.SetMethod("POST")
+	suite.context.Request.SetRequestURI("/relay/1234")
+	suite.mockPocketService.EXPECT().SendRelay(suite.mockSendRelayRequest()).
+		Return(nil, errors.New("relay to https://node.com failed"))
+
+	suite.mockRelayController.HandleRelay(suite.context)
+
+	suite.Equal(fasthttp.StatusBadGateway, suite.context.Response.StatusCode())
+	suite.NotContains(string(suite.context.Response.Body()), "node.com")
+}
+
+func (suite *RelayTestSuite) TestRelayUsageIsMetered() {
+	suite.mockUsageMeter = new(relay_usage_meter_mock.RelayUsageMeterService)
+	suite.mockRelayController = NewRelayController(suite.mockPocketService, suite.mockConfigProvider, suite.mockUsageMeter, zap.NewNop())
+
+	suite.context.Request.SetBody([]byte("test"))
+	suite.context.Request.Header.SetMethod("POST")
+	suite.context.Request.SetRequestURI("/relay/1234")
+	suite.context.SetUserValue(common.RelayCallerIdUserValue, "caller")
+	suite.mockPocketService.EXPECT().SendRelay(suite.mockSendRelayRequest()).
+		Return(&models.SendRelayResponse{Response: "response", Altruist: true}, nil)
+	suite.mockUsageMeter.EXPECT().RecordRelay(relay_usage_meter.RelayUsage{
+		CallerId:      "caller",
+		ChainId:       "1234",
+		Success:       true,
+		Altruist:      true,
+		RequestBytes:  4,
+		ResponseBytes: 8,
+	}).Once()
+
+	suite.mockRelayController.HandleRelay(suite.context)
+
+	suite.Equal(fasthttp.StatusOK, suite.context.Response.StatusCode())
+	suite.mockUsageMeter.AssertExpectations(suite.T())
+}
+
 // test for the getPathSegmented function in relay.go file using table driven tests to test different scenarios for the function
 func (suite *RelayTestSuite) TestGetPathSegmented() {
 
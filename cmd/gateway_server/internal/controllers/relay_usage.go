@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"context"
+	"encoding/csv"
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/transform"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+	"strconv"
+	"time"
+)
+
+const relayUsageFormatCsv = "csv"
+
+var relayUsageCsvHeader = []string{"caller_id", "chain_id", "hour", "successful_relays", "failed_relays", "altruist_relays", "request_bytes", "response_bytes"}
+
+// RelayUsageController handles requests for the metered relay usage
+type RelayUsageController struct {
+	logger *zap.Logger
+	query  db_query.Querier
+}
+
+// NewRelayUsageController creates a new instance of RelayUsageController.
+func NewRelayUsageController(query db_query.Querier, logger *zap.Logger) *RelayUsageController {
+	return &RelayUsageController{query: query, logger: logger}
+}
+
+// GetUsage returns the hourly relay usage between the start (inclusive) and end (exclusive) query parameters as JSON,
+// or CSV with format=csv. The range defaults to the current UTC month.
+func (c *RelayUsageController) GetUsage(ctx *fasthttp.RequestCtx) {
+	now := time.Now().UTC()
+	start, err := parseUsageTime(ctx.QueryArgs().Peek("start"), time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		common.JSONError(ctx, "Invalid start, expected YYYY-MM-DD or RFC3339", fasthttp.StatusBadRequest, err)
+		return
+	}
+	end, err := parseUsageTime(ctx.QueryArgs().Peek("end"), now.Truncate(time.Hour).Add(time.Hour))
+	if err != nil {
+		common.JSONError(ctx, "Invalid end, expected YYYY-MM-DD or RFC3339", fasthttp.StatusBadRequest, err)
+		return
+	}
+	if !start.Before(end) {
+		common.JSONError(ctx, "Start must be before end", fasthttp.StatusBadRequest, nil)
+		return
+	}
+
+	usage, err := c.query.GetRelayUsage(context.Background(), db_query.GetRelayUsageParams{
+		StartHour: pgtype.Timestamp{Time: start, Status: pgtype.Present},
+		EndHour:   pgtype.Timestamp{Time: end, Status: pgtype.Present},
+		CallerID:  string(ctx.QueryArgs().Peek("caller_id")),
+	})
+	if err != nil {
+		common.JSONError(ctx, "Something went wrong", fasthttp.StatusInternalServerError, err)
+		return
+	}
+	usagePublic := []*models.PublicRelayUsage{}
+	for _, row := range usage {
+		usagePublic = append(usagePublic, transform.ToRelayUsage(row))
+	}
+
+	if string(ctx.QueryArgs().Peek("format")) == relayUsageFormatCsv {
+		writeRelayUsageCsv(ctx, usagePublic)
+		return
+	}
+	common.JSONSuccess(ctx, usagePublic, fasthttp.StatusOK)
+}
+
+func writeRelayUsageCsv(ctx *fasthttp.RequestCtx, usage []*models.PublicRelayUsage) {
+	ctx.Response.Header.Set("Content-Type", "text/csv")
+	ctx.Response.Header.Set("Content-Disposition", `attachment; filename="relay_usage.csv"`)
+	ctx.SetStatusCode(fasthttp.StatusOK)
+
+	writer := csv.NewWriter(ctx)
+	writer.Write(relayUsageCsvHeader)
+	for _, row := range usage {
+		writer.Write([]string{
+			row.CallerID,
+			row.ChainID,
+			row.Hour.Format(time.RFC3339),
+			strconv.Itoa(row.SuccessfulRelays),
+			strconv.Itoa(row.FailedRelays),
+			strconv.Itoa(row.AltruistRelays),
+			strconv.Itoa(row.RequestBytes),
+			strconv.Itoa(row.ResponseBytes),
+		})
+	}
+	writer.Flush()
+}
+
+// parseUsageTime - parses a date or RFC3339 time in UTC, returning the default value if the time is empty.
+func parseUsageTime(value []byte, defaultValue time.Time) (time.Time, error) {
+	if len(value) == 0 {
+		return defaultValue, nil
+	}
+	if parsed, err := time.Parse(time.DateOnly, string(value)); err == nil {
+		return parsed, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, string(value))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parsed.UTC(), nil
+}
@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"errors"
+	"github.com/fasthttp/websocket"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/internal/relay_rate_limiter"
+	"github.com/pokt-network/gateway-server/internal/websocket_relayer"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+	"time"
+)
+
+var (
+	errWebsocketRateLimited   = errors.New("rate limit exceeded")
+	errWebsocketQuotaExceeded = errors.New("relay quota exceeded")
+	errWebsocketUnauthorized  = errors.New("invalid api key")
+)
+
+// WebsocketController handles websocket connections for a specific chain.
+type WebsocketController struct {
+	logger           *zap.Logger
+	websocketRelayer websocket_relayer.WebsocketRelayerService
+	upgrader         websocket.FastHTTPUpgrader
+}
+
+// NewWebsocketController creates a new instance of WebsocketController.
+func NewWebsocketController(websocketRelayer websocket_relayer.WebsocketRelayerService, logger *zap.Logger) *WebsocketController {
+	return &WebsocketController{
+		websocketRelayer: websocketRelayer,
+		logger:           logger,
+		upgrader: websocket.FastHTTPUpgrader{
+			// Like relays, websockets are served to any origin
+			CheckOrigin: func(ctx *fasthttp.RequestCtx) bool { return true },
+		},
+	}
+}
+
+// HandleWebsocket upgrades the request to a websocket that is relayed to the chain's websocket upstream.
+func (c *WebsocketController) HandleWebsocket(ctx *fasthttp.RequestCtx) {
+
+	chainID, _ := getPathSegmented(ctx.Path())
+
+	// Check if the chain ID is empty or has an incorrect length.
+	if chainID == "" || len(chainID) != chainIdLength {
+		common.JSONError(ctx, "Incorrect chain id", fasthttp.StatusBadRequest, nil)
+		return
+	}
+
+	if !c.websocketRelayer.IsChainSupported(chainID) {
+		common.JSONError(ctx, "Websocket not supported for chain", fasthttp.StatusNotFound, nil)
+		return
+	}
+
+	// User values are released once the connection is upgraded, so the caller is read beforehand. Anonymous callers
+	// are metered with an empty caller id.
+	callerId, _ := ctx.UserValue(common.RelayCallerIdUserValue).(string)
+	caller := websocket_relayer.Caller{Id: callerId, AllowRequest: getWebsocketRequestLimit(ctx)}
+	err := c.upgrader.Upgrade(ctx, func(conn *websocket.Conn) {
+		defer conn.Close()
+		c.websocketRelayer.ServeClient(chainID, conn, caller)
+	})
+	if err != nil {
+		c.logger.Debug("Error upgrading websocket", zap.Error(err))
+	}
+}
+
+// getWebsocketRequestLimit - returns a function that consumes a relay of the caller's api key for every websocket
+// request, or nil if the caller does not have limits.
+func getWebsocketRequestLimit(ctx *fasthttp.RequestCtx) func() error {
+	limit, ok := ctx.UserValue(common.RelayLimitUserValue).(func() (relay_rate_limiter.RelayLimitResult, time.Duration))
+	if !ok {
+		return nil
+	}
+	return func() error {
+		result, _ := limit()
+		switch result {
+		case relay_rate_limiter.RelayAllowed:
+			return nil
+		case relay_rate_limiter.RelayRateLimited:
+			return errWebsocketRateLimited
+		case relay_rate_limiter.RelayQuotaExceeded:
+			return errWebsocketQuotaExceeded
+		default:
+			return errWebsocketUnauthorized
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/internal/gateway_endpoints_registry"
+	"github.com/valyala/fasthttp"
+	"strings"
+)
+
+// GatewayEndpointAuth attributes relays to the gateway endpoint of the caller's api key, rejecting disabled endpoints
+// and chains the endpoint is not allowed to relay to. Unknown api keys are left to the next handler.
+func GatewayEndpointAuth(h fasthttp.RequestHandler, gatewayEndpointsRegistry gateway_endpoints_registry.GatewayEndpointsService) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		apiKey := retrieveRelayAPIKey(ctx)
+		if apiKey == "" {
+			h(ctx)
+			return
+		}
+		endpoint, ok := gatewayEndpointsRegistry.GetGatewayEndpoint(apiKey)
+		if !ok {
+			h(ctx)
+			return
+		}
+		if !gateway_endpoints_registry.IsEndpointEnabled(endpoint) {
+			common.JSONError(ctx, "Forbidden, endpoint is disabled", fasthttp.StatusForbidden, nil)
+			return
+		}
+		if !gateway_endpoints_registry.IsChainAllowed(endpoint, retrieveRelayChainId(ctx)) {
+			common.JSONError(ctx, "Forbidden, chain is not allowed for endpoint", fasthttp.StatusForbidden, nil)
+			return
+		}
+		endpointId, _ := endpoint.ID.Value()
+		ctx.SetUserValue(common.GatewayEndpointIdUserValue, endpointId)
+		ctx.SetUserValue(common.RelayCallerIdUserValue, endpointId)
+		h(ctx)
+	}
+}
+
+// retrieveRelayChainId returns the chain of the relay route, such as 0021 for /relay/0021/path
+func retrieveRelayChainId(ctx *fasthttp.RequestCtx) string {
+	catchAll, _ := ctx.UserValue("catchAll").(string)
+	chainId, _, _ := strings.Cut(catchAll, "/")
+	return chainId
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	config2 "github.com/pokt-network/gateway-server/internal/global_config"
+	"github.com/pokt-network/gateway-server/internal/relay_rate_limiter"
+	pkg_common "github.com/pokt-network/gateway-server/pkg/common"
+	"github.com/valyala/fasthttp"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// retrieveRelayAPIKey returns the api key of the path token, such as /v1/{api_key}/relay/0021, or the x-api-key header
+func retrieveRelayAPIKey(ctx *fasthttp.RequestCtx) string {
+	if apiKey, ok := ctx.UserValue("api_key").(string); ok && apiKey != "" {
+		return apiKey
+	}
+	return retrieveAPIKey(ctx)
+}
+
+// RelayRateLimit applies the rate limits and quotas of the caller's api key to relays and websocket connections
+func RelayRateLimit(h fasthttp.RequestHandler, relayRateLimiter relay_rate_limiter.RelayRateLimiterService, provider config2.RelayConfigProvider) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		// Path tokens are stripped so the handler sees the same path as /relay/{chain_id} or /websocket/{chain_id}
+		if ctx.UserValue("api_key") != nil {
+			_, route, _ := strings.Cut(strings.TrimPrefix(string(ctx.Path()), "/v1/"), "/")
+			ctx.URI().SetPath("/" + route)
+		}
+
+		apiKey := retrieveRelayAPIKey(ctx)
+		if apiKey == "" {
+			if provider.IsRelayAPIKeyRequired() {
+				common.JSONError(ctx, "Unauthorized, missing api key", fasthttp.StatusUnauthorized, nil)
+				return
+			}
+			h(ctx)
+			return
+		}
+
+		result, retryAfter := relayRateLimiter.Allow(apiKey)
+		switch result {
+		case relay_rate_limiter.RelayAllowed:
+			if ctx.UserValue(common.RelayCallerIdUserValue) == nil {
+				ctx.SetUserValue(common.RelayCallerIdUserValue, pkg_common.Sha256HashHex(apiKey))
+			}
+			ctx.SetUserValue(common.RelayLimitUserValue, func() (relay_rate_limiter.RelayLimitResult, time.Duration) {
+				return relayRateLimiter.Allow(apiKey)
+			})
+			h(ctx)
+		case relay_rate_limiter.RelayUnknownAPIKey:
+			// Gateway endpoints are not required to have rate limits
+			if ctx.UserValue(common.GatewayEndpointIdUserValue) != nil {
+				h(ctx)
+				return
+			}
+			common.JSONError(ctx, "Unauthorized, invalid api key", fasthttp.StatusUnauthorized, nil)
+		case relay_rate_limiter.RelayRateLimited:
+			setRetryAfter(ctx, retryAfter)
+			common.JSONError(ctx, "Too many requests, rate limit exceeded", fasthttp.StatusTooManyRequests, nil)
+		case relay_rate_limiter.RelayQuotaExceeded:
+			setRetryAfter(ctx, retryAfter)
+			common.JSONError(ctx, "Too many requests, relay quota exceeded", fasthttp.StatusTooManyRequests, nil)
+		}
+	}
+}
+
+func setRetryAfter(ctx *fasthttp.RequestCtx, retryAfter time.Duration) {
+	seconds := max(1, int(math.Ceil(retryAfter.Seconds())))
+	ctx.Response.Header.Set(fasthttp.HeaderRetryAfter, strconv.Itoa(seconds))
+}
@@ -1,11 +1,20 @@
 package middleware
 
 import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgtype"
 	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/common"
+	"github.com/pokt-network/gateway-server/internal/admin_keys_registry"
+	"github.com/pokt-network/gateway-server/internal/db_query"
 	config2 "github.com/pokt-network/gateway-server/internal/global_config"
 	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
 )
 
+// rootAdminAPIKeyName is the name audit logs use for the API_KEY environment variable, which is granted every scope
+const rootAdminAPIKeyName = "root"
+
 func retrieveAPIKey(ctx *fasthttp.RequestCtx) string {
 	auth := ctx.Request.Header.Peek("x-api-key")
 	if auth == nil {
@@ -14,17 +23,58 @@ func retrieveAPIKey(ctx *fasthttp.RequestCtx) string {
 	return string(auth)
 }
 
-// BasicAuth is the basic auth handler
-func XAPIKeyAuth(h fasthttp.RequestHandler, provider config2.SecretProvider) fasthttp.RequestHandler {
+// AdminAuth authenticates admin requests with the root API_KEY or a scoped admin api key
+type AdminAuth struct {
+	secretProvider    config2.SecretProvider
+	adminKeysRegistry admin_keys_registry.AdminKeysService
+	query             db_query.Querier
+	logger            *zap.Logger
+}
+
+func NewAdminAuth(secretProvider config2.SecretProvider, adminKeysRegistry admin_keys_registry.AdminKeysService, query db_query.Querier, logger *zap.Logger) *AdminAuth {
+	return &AdminAuth{secretProvider: secretProvider, adminKeysRegistry: adminKeysRegistry, query: query, logger: logger}
+}
+
+// XAPIKeyAuth requires the x-api-key header to be granted the scope. Requests of known keys are audit logged with the key id.
+func (a *AdminAuth) XAPIKeyAuth(h fasthttp.RequestHandler, scope string) fasthttp.RequestHandler {
 	return func(ctx *fasthttp.RequestCtx) {
 		// Get the Basic Authentication credentials
 		xAPIKey := retrieveAPIKey(ctx)
+		if xAPIKey == "" {
+			common.JSONError(ctx, "Unauthorized, invalid x-api-key header", fasthttp.StatusUnauthorized, nil)
+			return
+		}
 
-		if xAPIKey != "" && xAPIKey == provider.GetAPIKey() {
-			h(ctx)
+		auditLog := db_query.InsertAdminAuditLogParams{Scope: scope, Method: string(ctx.Method()), Path: string(ctx.Path())}
+		authorized := false
+		if xAPIKey == a.secretProvider.GetAPIKey() {
+			auditLog.AdminAPIKeyName = rootAdminAPIKeyName
+			auditLog.AdminAPIKeyID.Status = pgtype.Null
+			ctx.SetUserValue(common.AdminScopesUserValue, admin_keys_registry.Scopes)
+			authorized = true
+		} else if adminAPIKey, ok := a.adminKeysRegistry.GetAdminAPIKey(xAPIKey); ok {
+			auditLog.AdminAPIKeyName = adminAPIKey.Name.String
+			auditLog.AdminAPIKeyID = adminAPIKey.ID
+			ctx.SetUserValue(common.AdminScopesUserValue, adminAPIKey.Scopes)
+			authorized = admin_keys_registry.HasScope(adminAPIKey, scope)
+		} else {
+			common.JSONError(ctx, "Unauthorized, invalid x-api-key header", fasthttp.StatusUnauthorized, nil)
 			return
 		}
-		// Request Basic Authentication otherwise
-		common.JSONError(ctx, "Unauthorized, invalid x-api-key header", fasthttp.StatusUnauthorized, nil)
+
+		if authorized {
+			h(ctx)
+		} else {
+			common.JSONError(ctx, fmt.Sprintf("Forbidden, x-api-key is missing the %s scope", scope), fasthttp.StatusForbidden, nil)
+		}
+		auditLog.StatusCode = int32(ctx.Response.StatusCode())
+		a.writeAuditLog(auditLog)
+	}
+}
+
+func (a *AdminAuth) writeAuditLog(auditLog db_query.InsertAdminAuditLogParams) {
+	a.logger.Sugar().Infow("admin request", "adminApiKeyName", auditLog.AdminAPIKeyName, "scope", auditLog.Scope, "method", auditLog.Method, "path", auditLog.Path, "statusCode", auditLog.StatusCode)
+	if _, err := a.query.InsertAdminAuditLog(context.Background(), auditLog); err != nil {
+		a.logger.Sugar().Warnw("failed to write admin audit log", "err", err)
 	}
 }
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+type PublicAdminAPIKey struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+	// Only returned when the key is created, since only its hash is stored
+	APIKey string `json:"api_key,omitempty"`
+}
+
+type PublicAdminAuditLog struct {
+	AdminAPIKeyID   string    `json:"admin_api_key_id"`
+	AdminAPIKeyName string    `json:"admin_api_key_name"`
+	Scope           string    `json:"scope"`
+	Method          string    `json:"method"`
+	Path            string    `json:"path"`
+	StatusCode      int       `json:"status_code"`
+	CreatedAt       time.Time `json:"created_at"`
+}
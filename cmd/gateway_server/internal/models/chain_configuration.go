@@ -0,0 +1,25 @@
+package models
+
+// PublicChainConfiguration is both returned by and sent to the chain configurations endpoints, optional settings
+// are omitted when they are not set.
+type PublicChainConfiguration struct {
+	ID                               string            `json:"id,omitempty"`
+	ChainID                          string            `json:"chain_id"`
+	PocketRequestTimeoutDuration     string            `json:"pocket_request_timeout_duration"`
+	AltruistUrl                      string            `json:"altruist_url"`
+	AltruistRequestTimeoutDuration   string            `json:"altruist_request_timeout_duration"`
+	TopBucketP90latencyDuration      string            `json:"top_bucket_p90latency_duration"`
+	HeightCheckBlockTolerance        int32             `json:"height_check_block_tolerance"`
+	DataIntegrityCheckLookbackHeight int32             `json:"data_integrity_check_lookback_height"`
+	NodeSelectionStrategy            string            `json:"node_selection_strategy,omitempty"`
+	RelayMaxAttempts                 int32             `json:"relay_max_attempts,omitempty"`
+	RelayRetryDeadlineDuration       string            `json:"relay_retry_deadline_duration,omitempty"`
+	RelayHedgeDelayDuration          string            `json:"relay_hedge_delay_duration,omitempty"`
+	BatchRelayMode                   string            `json:"batch_relay_mode,omitempty"`
+	AltruistWebsocketUrl             string            `json:"altruist_websocket_url,omitempty"`
+	RelayCacheTtls                   map[string]string `json:"relay_cache_ttls,omitempty"`
+	RelayValidationSampleRate        float32           `json:"relay_validation_sample_rate,omitempty"`
+	EvmChainID                       string            `json:"evm_chain_id,omitempty"`
+	ArchivalCheckBlockHeight         int32             `json:"archival_check_block_height,omitempty"`
+	ChainFamily                      string            `json:"chain_family,omitempty"`
+}
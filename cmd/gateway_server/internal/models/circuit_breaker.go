@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type PublicCircuitBreaker struct {
+	ChainID     string    `json:"chain_id"`
+	State       string    `json:"state"`
+	Calls       int       `json:"calls"`
+	FailedCalls int       `json:"failed_calls"`
+	OpenedAt    time.Time `json:"opened_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+type PublicGatewayUser struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+type PublicGatewayEndpoint struct {
+	ID            string   `json:"id"`
+	UserID        string   `json:"user_id"`
+	Name          string   `json:"name"`
+	AllowedChains []string `json:"allowed_chains"`
+	Enabled       bool     `json:"enabled"`
+	// Only returned when the endpoint is created, since only its hash is stored
+	APIKey string `json:"api_key,omitempty"`
+}
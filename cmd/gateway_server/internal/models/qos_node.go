@@ -13,6 +13,7 @@ type PublicQosNode struct {
 	LastKnownErr    string    `json:"last_known_err"`
 	IsHealthy       bool      `json:"is_healthy"`
 	IsSynced        bool      `json:"is_synced"`
+	IsArchival      bool      `json:"is_archival"`
 	LastKnownHeight uint64    `json:"last_known_height"`
 	P90Latency      float64   `json:"p90_latency"`
 }
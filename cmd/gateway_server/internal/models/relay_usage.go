@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type PublicRelayUsage struct {
+	CallerID         string    `json:"caller_id"`
+	ChainID          string    `json:"chain_id"`
+	Hour             time.Time `json:"hour"`
+	SuccessfulRelays int       `json:"successful_relays"`
+	FailedRelays     int       `json:"failed_relays"`
+	AltruistRelays   int       `json:"altruist_relays"`
+	RequestBytes     int       `json:"request_bytes"`
+	ResponseBytes    int       `json:"response_bytes"`
+}
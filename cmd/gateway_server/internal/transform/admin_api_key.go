@@ -0,0 +1,38 @@
+package transform
+
+import (
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+)
+
+func ToAdminAPIKey(adminAPIKey db_query.GetAdminAPIKeysRow) *models.PublicAdminAPIKey {
+	id, _ := adminAPIKey.ID.Value()
+	scopes := adminAPIKey.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return &models.PublicAdminAPIKey{
+		ID:     id.(string),
+		Name:   adminAPIKey.Name.String,
+		Scopes: scopes,
+	}
+}
+
+func ToAdminAuditLog(auditLog db_query.GetAdminAuditLogsRow) *models.PublicAdminAuditLog {
+	// The root API_KEY does not have an id
+	id, _ := auditLog.AdminAPIKeyID.Value()
+	adminAPIKeyId, _ := id.(string)
+	statusCode := 0
+	if auditLog.StatusCode != nil {
+		statusCode = int(*auditLog.StatusCode)
+	}
+	return &models.PublicAdminAuditLog{
+		AdminAPIKeyID:   adminAPIKeyId,
+		AdminAPIKeyName: auditLog.AdminAPIKeyName.String,
+		Scope:           auditLog.Scope.String,
+		Method:          auditLog.Method.String,
+		Path:            auditLog.Path.String,
+		StatusCode:      statusCode,
+		CreatedAt:       auditLog.CreatedAt.Time.UTC(),
+	}
+}
@@ -0,0 +1,51 @@
+package transform
+
+import (
+	"encoding/json"
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+)
+
+func ToChainConfiguration(chainConfig db_query.GetChainConfigurationsRow) *models.PublicChainConfiguration {
+	id, _ := chainConfig.ID.Value()
+	var relayCacheTtls map[string]string
+	if chainConfig.RelayCacheTtls.String != "" {
+		// Configurations written with raw SQL may not be valid, which the relayer ignores as well
+		json.Unmarshal([]byte(chainConfig.RelayCacheTtls.String), &relayCacheTtls)
+	}
+	return &models.PublicChainConfiguration{
+		ID:                               id.(string),
+		ChainID:                          chainConfig.ChainID.String,
+		PocketRequestTimeoutDuration:     chainConfig.PocketRequestTimeoutDuration.String,
+		AltruistUrl:                      chainConfig.AltruistUrl.String,
+		AltruistRequestTimeoutDuration:   chainConfig.AltruistRequestTimeoutDuration.String,
+		TopBucketP90latencyDuration:      chainConfig.TopBucketP90latencyDuration.String,
+		HeightCheckBlockTolerance:        derefInt32(chainConfig.HeightCheckBlockTolerance),
+		DataIntegrityCheckLookbackHeight: derefInt32(chainConfig.DataIntegrityCheckLookbackHeight),
+		NodeSelectionStrategy:            chainConfig.NodeSelectionStrategy.String,
+		RelayMaxAttempts:                 derefInt32(chainConfig.RelayMaxAttempts),
+		RelayRetryDeadlineDuration:       chainConfig.RelayRetryDeadlineDuration.String,
+		RelayHedgeDelayDuration:          chainConfig.RelayHedgeDelayDuration.String,
+		BatchRelayMode:                   chainConfig.BatchRelayMode.String,
+		AltruistWebsocketUrl:             chainConfig.AltruistWebsocketUrl.String,
+		RelayCacheTtls:                   relayCacheTtls,
+		RelayValidationSampleRate:        derefFloat32(chainConfig.RelayValidationSampleRate),
+		EvmChainID:                       chainConfig.EvmChainID.String,
+		ArchivalCheckBlockHeight:         derefInt32(chainConfig.ArchivalCheckBlockHeight),
+		ChainFamily:                      chainConfig.ChainFamily.String,
+	}
+}
+
+func derefInt32(value *int32) int32 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func derefFloat32(value *float32) float32 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
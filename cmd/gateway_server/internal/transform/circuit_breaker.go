@@ -0,0 +1,16 @@
+package transform
+
+import (
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/pokt-network/gateway-server/internal/altruist_circuit_breaker"
+)
+
+func ToPublicCircuitBreaker(circuit altruist_circuit_breaker.Circuit) *models.PublicCircuitBreaker {
+	return &models.PublicCircuitBreaker{
+		ChainID:     circuit.ChainID,
+		State:       string(circuit.State),
+		Calls:       circuit.Calls,
+		FailedCalls: circuit.FailedCalls,
+		OpenedAt:    circuit.OpenedAt,
+	}
+}
@@ -0,0 +1,32 @@
+package transform
+
+import (
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/gateway_endpoints_registry"
+)
+
+func ToGatewayUser(user db_query.GetGatewayUsersRow) *models.PublicGatewayUser {
+	id, _ := user.ID.Value()
+	return &models.PublicGatewayUser{
+		ID:    id.(string),
+		Email: user.Email.String,
+		Name:  user.Name.String,
+	}
+}
+
+func ToGatewayEndpoint(endpoint db_query.GetGatewayEndpointsRow) *models.PublicGatewayEndpoint {
+	id, _ := endpoint.ID.Value()
+	userId, _ := endpoint.UserID.Value()
+	allowedChains := endpoint.AllowedChains
+	if allowedChains == nil {
+		allowedChains = []string{}
+	}
+	return &models.PublicGatewayEndpoint{
+		ID:            id.(string),
+		UserID:        userId.(string),
+		Name:          endpoint.Name.String,
+		AllowedChains: allowedChains,
+		Enabled:       gateway_endpoints_registry.IsEndpointEnabled(endpoint),
+	}
+}
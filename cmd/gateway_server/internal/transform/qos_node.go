@@ -12,18 +12,20 @@ func ToPublicQosNode(node *internal_model.QosNode) *models.PublicQosNode {
 	if math.IsNaN(latency) {
 		latency = 0.0
 	}
+	health := node.GetHealth()
 	return &models.PublicQosNode{
 		NodePublicKey:   node.MorseNode.PublicKey,
 		ServiceUrl:      node.MorseNode.ServiceUrl,
 		Chain:           node.GetChain(),
 		SessionHeight:   node.MorseSession.SessionHeader.SessionHeight,
 		AppPublicKey:    node.MorseSigner.PublicKey,
-		TimeoutReason:   string(node.GetTimeoutReason()),
-		LastKnownErr:    node.GetLastKnownErrorStr(),
-		IsHealthy:       node.IsHealthy(),
-		IsSynced:        node.IsSynced(),
-		LastKnownHeight: node.GetLastKnownHeight(),
-		TimeoutUntil:    node.GetTimeoutUntil(),
+		TimeoutReason:   string(health.TimeoutReason),
+		LastKnownErr:    health.GetLastKnownErrorStr(),
+		IsHealthy:       health.IsHealthy(),
+		IsSynced:        health.Synced,
+		IsArchival:      health.Archival,
+		LastKnownHeight: health.LatestKnownHeight,
+		TimeoutUntil:    health.TimeoutUntil,
 		P90Latency:      latency,
 	}
 }
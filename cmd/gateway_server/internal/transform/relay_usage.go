@@ -0,0 +1,26 @@
+package transform
+
+import (
+	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/models"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+)
+
+func ToRelayUsage(usage db_query.GetRelayUsageRow) *models.PublicRelayUsage {
+	return &models.PublicRelayUsage{
+		CallerID:         usage.CallerID.String,
+		ChainID:          usage.ChainID.String,
+		Hour:             usage.Hour.Time.UTC(),
+		SuccessfulRelays: derefInt(usage.SuccessfulRelays),
+		FailedRelays:     derefInt(usage.FailedRelays),
+		AltruistRelays:   derefInt(usage.AltruistRelays),
+		RequestBytes:     derefInt(usage.RequestBytes),
+		ResponseBytes:    derefInt(usage.ResponseBytes),
+	}
+}
+
+func derefInt(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/fasthttp/router"
 	fasthttpprometheus "github.com/flf2ko/fasthttp-prometheus"
@@ -8,16 +9,28 @@ import (
 	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/config"
 	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/controllers"
 	"github.com/pokt-network/gateway-server/cmd/gateway_server/internal/middleware"
+	"github.com/pokt-network/gateway-server/internal/admin_keys_registry"
+	"github.com/pokt-network/gateway-server/internal/altruist_circuit_breaker"
+	"github.com/pokt-network/gateway-server/internal/altruist_registry"
 	"github.com/pokt-network/gateway-server/internal/apps_registry"
+	"github.com/pokt-network/gateway-server/internal/chain_checks_registry"
 	"github.com/pokt-network/gateway-server/internal/chain_configurations_registry"
 	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/gateway_endpoints_registry"
 	"github.com/pokt-network/gateway-server/internal/logging"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service"
 	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"github.com/pokt-network/gateway-server/internal/relay_rate_limiter"
+	"github.com/pokt-network/gateway-server/internal/relay_usage_meter"
 	"github.com/pokt-network/gateway-server/internal/relayer"
 	"github.com/pokt-network/gateway-server/internal/session_registry"
+	"github.com/pokt-network/gateway-server/internal/websocket_relayer"
 	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0"
 	"github.com/valyala/fasthttp"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 const (
@@ -25,6 +38,8 @@ const (
 	// Maximum amount of DB connections opened at a time. This should not have to be modified
 	// as most of our database queries are periodic and not ran concurrently.
 	maxDbConns = 50
+	// Time in-flight requests are given to finish on shutdown
+	shutdownTimeout = time.Second * 30
 )
 
 func main() {
@@ -67,30 +82,97 @@ func main() {
 	poktApplicationRegistry := apps_registry.NewCachedAppsRegistry(client, querier, gatewayConfigProvider, logger.Named("pokt_application_registry"))
 	chainConfigurationRegistry := chain_configurations_registry.NewCachedChainConfigurationRegistry(querier, logger.Named("chain_configurations_registry"))
 	sessionRegistry := session_registry.NewCachedSessionRegistryService(client, poktApplicationRegistry, sessionCache, nodeCache, logger.Named("session_registry"))
-	nodeSelectorService := node_selector_service.NewNodeSelectorService(sessionRegistry, client, chainConfigurationRegistry, gatewayConfigProvider, logger.Named("node_selector"))
+	chainChecksRegistry := chain_checks_registry.NewCachedChainChecksRegistry(querier, logger.Named("chain_checks_registry"))
+	nodeSelectorService := node_selector_service.NewNodeSelectorService(sessionRegistry, client, chainConfigurationRegistry, chainChecksRegistry, logger.Named("node_selector"))
 
-	relayer := relayer.NewRelayer(client, sessionRegistry, poktApplicationRegistry, nodeSelectorService, chainConfigurationRegistry, userAgent, gatewayConfigProvider, logger.Named("relayer"))
+	altruistRegistry := altruist_registry.NewCachedAltruistRegistry(querier, chainConfigurationRegistry, logger.Named("altruist_registry"))
+
+	altruistCircuitBreaker := altruist_circuit_breaker.NewChainCircuitBreakers(logger.Named("altruist_circuit_breaker"))
+
+	relayer := relayer.NewRelayer(client, sessionRegistry, poktApplicationRegistry, nodeSelectorService, chainConfigurationRegistry, altruistRegistry, altruistCircuitBreaker, userAgent, gatewayConfigProvider, logger.Named("relayer"))
 
 	// Define routers
 	r := router.New()
 
 	// Create a relay controller with the necessary dependencies (logger, registry, cached relayer)
-	relayController := controllers.NewRelayController(relayer, logger.Named("relay_controller"))
+	relayUsageMeter := relay_usage_meter.NewBufferedRelayUsageMeter(querier, logger.Named("relay_usage_meter"))
+	relayController := controllers.NewRelayController(relayer, gatewayConfigProvider, relayUsageMeter, logger.Named("relay_controller"))
+
+	relayRateLimiter := relay_rate_limiter.NewCachedRelayRateLimiter(querier, logger.Named("relay_rate_limiter"))
+	gatewayEndpointsRegistry := gateway_endpoints_registry.NewCachedGatewayEndpointsRegistry(querier, logger.Named("gateway_endpoints_registry"))
+	relayHandler := middleware.GatewayEndpointAuth(middleware.RelayRateLimit(relayController.HandleRelay, relayRateLimiter, gatewayConfigProvider), gatewayEndpointsRegistry)
 
 	relayRouter := r.Group("/relay")
-	relayRouter.POST("/{catchAll:*}", relayController.HandleRelay)
+	relayRouter.POST("/{catchAll:*}", relayHandler)
+	relayRouter.GET("/{catchAll:*}", relayHandler)
+
+	// Clients that cannot set headers pass their api key as a path token
+	apiKeyRelayRouter := r.Group("/v1/{api_key}/relay")
+	apiKeyRelayRouter.POST("/{catchAll:*}", relayHandler)
+	apiKeyRelayRouter.GET("/{catchAll:*}", relayHandler)
+
+	websocketRelayer := websocket_relayer.NewWebsocketRelayer(chainConfigurationRegistry, relayUsageMeter, logger.Named("websocket_relayer"))
+	websocketController := controllers.NewWebsocketController(websocketRelayer, logger.Named("websocket_controller"))
+
+	websocketHandler := middleware.GatewayEndpointAuth(middleware.RelayRateLimit(websocketController.HandleWebsocket, relayRateLimiter, gatewayConfigProvider), gatewayEndpointsRegistry)
+
+	websocketRouter := r.Group("/websocket")
+	websocketRouter.GET("/{catchAll:*}", websocketHandler)
+
+	apiKeyWebsocketRouter := r.Group("/v1/{api_key}/websocket")
+	apiKeyWebsocketRouter.GET("/{catchAll:*}", websocketHandler)
+
+	// Admin endpoints accept the root API_KEY or an admin api key granted the endpoint's scope
+	adminKeysRegistry := admin_keys_registry.NewCachedAdminKeysRegistry(querier, logger.Named("admin_keys_registry"))
+	adminAuth := middleware.NewAdminAuth(gatewayConfigProvider, adminKeysRegistry, querier, logger.Named("admin_auth"))
 
 	poktAppsController := controllers.NewPoktAppsController(poktApplicationRegistry, querier, gatewayConfigProvider, logger.Named("pokt_apps_controller"))
 	poktAppsRouter := r.Group("/poktapps")
 
-	poktAppsRouter.GET("/", middleware.XAPIKeyAuth(poktAppsController.GetAll, gatewayConfigProvider))
-	poktAppsRouter.POST("/", middleware.XAPIKeyAuth(poktAppsController.AddApplication, gatewayConfigProvider))
-	poktAppsRouter.DELETE("/{app_id}", middleware.XAPIKeyAuth(poktAppsController.DeleteApplication, gatewayConfigProvider))
+	poktAppsRouter.GET("/", adminAuth.XAPIKeyAuth(poktAppsController.GetAll, admin_keys_registry.ScopeAppsRead))
+	poktAppsRouter.POST("/", adminAuth.XAPIKeyAuth(poktAppsController.AddApplication, admin_keys_registry.ScopeAppsWrite))
+	poktAppsRouter.DELETE("/{app_id}", adminAuth.XAPIKeyAuth(poktAppsController.DeleteApplication, admin_keys_registry.ScopeAppsWrite))
+
+	gatewayEndpointsController := controllers.NewGatewayEndpointsController(gatewayEndpointsRegistry, querier, logger.Named("gateway_endpoints_controller"))
+	gatewayUsersRouter := r.Group("/gatewayusers")
+	gatewayUsersRouter.GET("/", adminAuth.XAPIKeyAuth(gatewayEndpointsController.GetAllUsers, admin_keys_registry.ScopeUsersRead))
+	gatewayUsersRouter.POST("/", adminAuth.XAPIKeyAuth(gatewayEndpointsController.AddUser, admin_keys_registry.ScopeUsersWrite))
+	gatewayUsersRouter.DELETE("/{user_id}", adminAuth.XAPIKeyAuth(gatewayEndpointsController.DeleteUser, admin_keys_registry.ScopeUsersWrite))
+
+	gatewayEndpointsRouter := r.Group("/gatewayendpoints")
+	gatewayEndpointsRouter.GET("/", adminAuth.XAPIKeyAuth(gatewayEndpointsController.GetAllEndpoints, admin_keys_registry.ScopeUsersRead))
+	gatewayEndpointsRouter.POST("/", adminAuth.XAPIKeyAuth(gatewayEndpointsController.AddEndpoint, admin_keys_registry.ScopeUsersWrite))
+	gatewayEndpointsRouter.PUT("/{endpoint_id}", adminAuth.XAPIKeyAuth(gatewayEndpointsController.UpdateEndpoint, admin_keys_registry.ScopeUsersWrite))
+	gatewayEndpointsRouter.DELETE("/{endpoint_id}", adminAuth.XAPIKeyAuth(gatewayEndpointsController.DeleteEndpoint, admin_keys_registry.ScopeUsersWrite))
+
+	chainConfigurationsController := controllers.NewChainConfigurationsController(chainConfigurationRegistry, altruistRegistry, querier, logger.Named("chain_configurations_controller"))
+	chainConfigurationsRouter := r.Group("/chainconfigurations")
+	chainConfigurationsRouter.GET("/", adminAuth.XAPIKeyAuth(chainConfigurationsController.GetAll, admin_keys_registry.ScopeChainsRead))
+	chainConfigurationsRouter.POST("/", adminAuth.XAPIKeyAuth(chainConfigurationsController.AddChainConfiguration, admin_keys_registry.ScopeChainsWrite))
+	chainConfigurationsRouter.PUT("/{chain_id}", adminAuth.XAPIKeyAuth(chainConfigurationsController.UpdateChainConfiguration, admin_keys_registry.ScopeChainsWrite))
+	chainConfigurationsRouter.DELETE("/{chain_id}", adminAuth.XAPIKeyAuth(chainConfigurationsController.DeleteChainConfiguration, admin_keys_registry.ScopeChainsWrite))
+
+	relayUsageController := controllers.NewRelayUsageController(querier, logger.Named("relay_usage_controller"))
+	relayUsageRouter := r.Group("/relayusage")
+	relayUsageRouter.GET("/", adminAuth.XAPIKeyAuth(relayUsageController.GetUsage, admin_keys_registry.ScopeUsageRead))
+
+	adminKeysController := controllers.NewAdminKeysController(adminKeysRegistry, querier, logger.Named("admin_keys_controller"))
+	adminKeysRouter := r.Group("/adminkeys")
+	adminKeysRouter.GET("/", adminAuth.XAPIKeyAuth(adminKeysController.GetAll, admin_keys_registry.ScopeAdminRead))
+	adminKeysRouter.POST("/", adminAuth.XAPIKeyAuth(adminKeysController.AddKey, admin_keys_registry.ScopeAdminWrite))
+	adminKeysRouter.DELETE("/{key_id}", adminAuth.XAPIKeyAuth(adminKeysController.DeleteKey, admin_keys_registry.ScopeAdminWrite))
+
+	adminAuditLogsRouter := r.Group("/adminauditlogs")
+	adminAuditLogsRouter.GET("/", adminAuth.XAPIKeyAuth(adminKeysController.GetAuditLogs, admin_keys_registry.ScopeAdminRead))
 
 	// Create qos controller for debugging purposes
 	qosNodeController := controllers.NewQosNodeController(sessionRegistry, logger.Named("qos_node_controller"))
 	qosNodeRouter := r.Group("/qosnodes")
-	qosNodeRouter.GET("/", middleware.XAPIKeyAuth(qosNodeController.GetAll, gatewayConfigProvider))
+	qosNodeRouter.GET("/", adminAuth.XAPIKeyAuth(qosNodeController.GetAll, admin_keys_registry.ScopeQosRead))
+
+	circuitBreakersController := controllers.NewCircuitBreakersController(altruistCircuitBreaker, logger.Named("circuit_breakers_controller"))
+	circuitBreakersRouter := r.Group("/circuitbreakers")
+	circuitBreakersRouter.GET("/", adminAuth.XAPIKeyAuth(circuitBreakersController.GetAll, admin_keys_registry.ScopeQosRead))
 
 	// Add Middleware for Generic E2E Prom Tracking
 	p := fasthttpprometheus.NewPrometheus("fasthttp")
@@ -98,8 +180,26 @@ func main() {
 
 	logger.Info("Gateway Server Started")
 	// Start the fasthttp server and listen on the configured server port
-	if err := fasthttp.ListenAndServe(fmt.Sprintf(":%d", gatewayConfigProvider.GetHTTPServerPort()), fastpHandler); err != nil {
-		// If an error occurs during server startup, log the error and exit
-		logger.Sugar().Fatalw("Error in ListenAndServe", "err", err)
+	server := &fasthttp.Server{Handler: fastpHandler}
+	go func() {
+		if err := server.ListenAndServe(fmt.Sprintf(":%d", gatewayConfigProvider.GetHTTPServerPort())); err != nil {
+			// If an error occurs during server startup, log the error and exit
+			logger.Sugar().Fatalw("Error in ListenAndServe", "err", err)
+		}
+	}()
+
+	// Relays are no longer served once the server is shut down, so the buffered relay usage can be flushed for the last time
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	<-signals
+	logger.Info("Gateway Server Shutting Down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.ShutdownWithContext(shutdownCtx); err != nil {
+		logger.Sugar().Warnw("failed to shut down server", "err", err)
+	}
+	nodeSelectorService.Stop()
+	if err := relayUsageMeter.Stop(); err != nil {
+		logger.Sugar().Warnw("failed to flush relay usage", "err", err)
 	}
 }
@@ -0,0 +1 @@
+ALTER TABLE chain_configurations ADD COLUMN node_selection_strategy VARCHAR;
@@ -0,0 +1,2 @@
+ALTER TABLE chain_configurations DROP COLUMN IF EXISTS relay_retry_deadline_duration;
+ALTER TABLE chain_configurations DROP COLUMN IF EXISTS relay_max_attempts;
@@ -0,0 +1,2 @@
+ALTER TABLE chain_configurations ADD COLUMN relay_max_attempts INT;
+ALTER TABLE chain_configurations ADD COLUMN relay_retry_deadline_duration VARCHAR;
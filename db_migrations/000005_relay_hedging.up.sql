@@ -0,0 +1 @@
+ALTER TABLE chain_configurations ADD COLUMN relay_hedge_delay_duration VARCHAR;
@@ -0,0 +1 @@
+ALTER TABLE chain_configurations ADD COLUMN batch_relay_mode VARCHAR;
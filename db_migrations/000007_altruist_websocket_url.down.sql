@@ -0,0 +1 @@
+ALTER TABLE chain_configurations DROP COLUMN IF EXISTS altruist_websocket_url;
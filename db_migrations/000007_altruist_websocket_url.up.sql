@@ -0,0 +1 @@
+ALTER TABLE chain_configurations ADD COLUMN altruist_websocket_url VARCHAR;
@@ -0,0 +1,3 @@
+-- Drop the tables 'relay_quota_usage' and 'relay_rate_limits'
+DROP TABLE IF EXISTS relay_quota_usage;
+DROP TABLE IF EXISTS relay_rate_limits;
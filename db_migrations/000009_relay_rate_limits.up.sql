@@ -0,0 +1,18 @@
+CREATE TABLE relay_rate_limits
+(
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    api_key_hash VARCHAR NOT NULL UNIQUE,
+    requests_per_second INT,
+    burst INT,
+    daily_relay_quota BIGINT,
+    monthly_relay_quota BIGINT
+) INHERITS (base_model);
+
+CREATE TABLE relay_quota_usage
+(
+    api_key_hash VARCHAR NOT NULL,
+    period VARCHAR NOT NULL,
+    period_start TIMESTAMP NOT NULL,
+    relay_count BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (api_key_hash, period, period_start)
+);
@@ -0,0 +1,3 @@
+-- Drop the tables 'gateway_endpoints' and 'gateway_users'
+DROP TABLE IF EXISTS gateway_endpoints;
+DROP TABLE IF EXISTS gateway_users;
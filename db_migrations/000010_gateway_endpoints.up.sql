@@ -0,0 +1,16 @@
+CREATE TABLE gateway_users
+(
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    email VARCHAR NOT NULL UNIQUE,
+    name VARCHAR NOT NULL DEFAULT ''
+) INHERITS (base_model);
+
+CREATE TABLE gateway_endpoints
+(
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES gateway_users (id) ON DELETE CASCADE,
+    name VARCHAR NOT NULL DEFAULT '',
+    api_key_hash VARCHAR NOT NULL UNIQUE,
+    allowed_chains TEXT[] NOT NULL DEFAULT '{}',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE
+) INHERITS (base_model);
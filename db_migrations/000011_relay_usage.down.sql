@@ -0,0 +1,2 @@
+-- Drop the table 'relay_usage'
+DROP TABLE IF EXISTS relay_usage;
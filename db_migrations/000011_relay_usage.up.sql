@@ -0,0 +1,14 @@
+CREATE TABLE relay_usage
+(
+    caller_id VARCHAR NOT NULL,
+    chain_id VARCHAR NOT NULL,
+    hour TIMESTAMP NOT NULL,
+    successful_relays BIGINT NOT NULL DEFAULT 0,
+    failed_relays BIGINT NOT NULL DEFAULT 0,
+    altruist_relays BIGINT NOT NULL DEFAULT 0,
+    request_bytes BIGINT NOT NULL DEFAULT 0,
+    response_bytes BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (caller_id, chain_id, hour)
+);
+
+CREATE INDEX relay_usage_hour_idx ON relay_usage (hour);
@@ -0,0 +1,3 @@
+-- Drop the tables 'admin_audit_logs' and 'admin_api_keys'
+DROP TABLE IF EXISTS admin_audit_logs;
+DROP TABLE IF EXISTS admin_api_keys;
@@ -0,0 +1,22 @@
+CREATE TABLE admin_api_keys
+(
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    name VARCHAR NOT NULL DEFAULT '',
+    api_key_hash VARCHAR NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL DEFAULT '{}'
+) INHERITS (base_model);
+
+-- Audit logs keep the id of deleted admin api keys, the root API_KEY is logged without an id
+CREATE TABLE admin_audit_logs
+(
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    admin_api_key_id UUID,
+    admin_api_key_name VARCHAR NOT NULL,
+    scope VARCHAR NOT NULL,
+    method VARCHAR NOT NULL,
+    path VARCHAR NOT NULL,
+    status_code INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX admin_audit_logs_created_at_idx ON admin_audit_logs (created_at);
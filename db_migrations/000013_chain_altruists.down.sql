@@ -0,0 +1,2 @@
+-- Drop the table 'chain_altruists'
+DROP TABLE IF EXISTS chain_altruists;
@@ -0,0 +1,11 @@
+CREATE TABLE chain_altruists
+(
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    chain_id VARCHAR NOT NULL,
+    url VARCHAR NOT NULL,
+    weight INT NOT NULL DEFAULT 1 CHECK (weight > 0),
+    auth_headers VARCHAR,
+    request_timeout_duration VARCHAR
+) INHERITS (base_model);
+
+CREATE INDEX chain_altruists_chain_id_idx ON chain_altruists (chain_id);
@@ -0,0 +1 @@
+ALTER TABLE chain_configurations ADD COLUMN relay_validation_sample_rate REAL;
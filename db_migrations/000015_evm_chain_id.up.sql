@@ -0,0 +1 @@
+ALTER TABLE chain_configurations ADD COLUMN evm_chain_id VARCHAR;
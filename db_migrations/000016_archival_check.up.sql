@@ -0,0 +1 @@
+ALTER TABLE chain_configurations ADD COLUMN archival_check_block_height INT;
@@ -0,0 +1 @@
+ALTER TABLE chain_configurations ADD COLUMN chain_family VARCHAR;
@@ -0,0 +1,2 @@
+-- Drop the table 'chain_checks'
+DROP TABLE IF EXISTS chain_checks;
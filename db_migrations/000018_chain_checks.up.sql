@@ -0,0 +1,17 @@
+CREATE TABLE chain_checks
+(
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    chain_id VARCHAR NOT NULL,
+    name VARCHAR NOT NULL,
+    method VARCHAR NOT NULL DEFAULT 'POST',
+    path VARCHAR NOT NULL DEFAULT '',
+    payload VARCHAR NOT NULL DEFAULT '',
+    json_path VARCHAR NOT NULL,
+    comparison VARCHAR NOT NULL CHECK (comparison IN ('height_tolerance', 'majority', 'expected_value')),
+    expected_value VARCHAR,
+    height_tolerance INT CHECK (height_tolerance >= 0),
+    interval_duration VARCHAR NOT NULL DEFAULT '5m',
+    penalty_duration VARCHAR NOT NULL DEFAULT '5m'
+) INHERITS (base_model);
+
+CREATE INDEX chain_checks_chain_id_idx ON chain_checks (chain_id);
@@ -0,0 +1,3 @@
+-- Chain families are no longer detected by chain id, keep the families of the Morse mainnet POKT and Solana chains
+UPDATE chain_configurations SET chain_family = 'pokt' WHERE chain_id = '0001' AND chain_family IS NULL;
+UPDATE chain_configurations SET chain_family = 'solana' WHERE chain_id IN ('0006', 'C006') AND chain_family IS NULL;
@@ -0,0 +1 @@
+-- The backfilled families are not distinguishable from configured families, so they are kept
@@ -0,0 +1,3 @@
+-- Chains without a family are no longer checked as EVM chains, keep the EVM checks of the existing configurations.
+-- The ids of the Morse mainnet and testnet POKT and Solana chains are ambiguous across networks, so their family must be set.
+UPDATE chain_configurations SET chain_family = 'evm' WHERE chain_family IS NULL AND chain_id NOT IN ('0001', '0006', 'C006', '0013', '0008');
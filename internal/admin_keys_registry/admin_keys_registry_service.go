@@ -0,0 +1,9 @@
+package admin_keys_registry
+
+import "github.com/pokt-network/gateway-server/internal/db_query"
+
+type AdminKeysService interface {
+	GetAdminAPIKey(apiKey string) (db_query.GetAdminAPIKeysRow, bool)
+	// Refresh - reloads the admin api keys, so that created and deleted keys apply right away.
+	Refresh() error
+}
@@ -0,0 +1,79 @@
+package admin_keys_registry
+
+import (
+	"context"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/pkg/common"
+	"go.uber.org/zap"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	adminAPIKeysUpdateInterval = time.Minute * 1
+)
+
+type CachedAdminKeysRegistry struct {
+	dbQuery           db_query.Querier
+	adminAPIKeysCache map[string]db_query.GetAdminAPIKeysRow // api key hash > admin api key
+	cacheLock         sync.RWMutex
+	logger            *zap.Logger
+}
+
+func NewCachedAdminKeysRegistry(dbQuery db_query.Querier, logger *zap.Logger) *CachedAdminKeysRegistry {
+	adminKeysRegistry := &CachedAdminKeysRegistry{dbQuery: dbQuery, adminAPIKeysCache: map[string]db_query.GetAdminAPIKeysRow{}, logger: logger}
+	err := adminKeysRegistry.Refresh()
+	if err != nil {
+		adminKeysRegistry.logger.Sugar().Warnw("Failed to retrieve admin api keys on startup", "err", err)
+	}
+	adminKeysRegistry.startCacheUpdater()
+	return adminKeysRegistry
+}
+
+func (r *CachedAdminKeysRegistry) GetAdminAPIKey(apiKey string) (db_query.GetAdminAPIKeysRow, bool) {
+	r.cacheLock.RLock()
+	defer r.cacheLock.RUnlock()
+	adminAPIKey, found := r.adminAPIKeysCache[common.Sha256HashHex(apiKey)]
+	return adminAPIKey, found
+}
+
+func (r *CachedAdminKeysRegistry) Refresh() error {
+	adminAPIKeys, err := r.dbQuery.GetAdminAPIKeys(context.Background())
+
+	if err != nil {
+		return err
+	}
+
+	adminAPIKeysNew := map[string]db_query.GetAdminAPIKeysRow{}
+	for _, row := range adminAPIKeys {
+		adminAPIKeysNew[row.ApiKeyHash.String] = row
+	}
+
+	// Update the cache
+	r.cacheLock.Lock()
+	defer r.cacheLock.Unlock()
+	r.adminAPIKeysCache = adminAPIKeysNew
+	return nil
+}
+
+// startCacheUpdater starts a goroutine to periodically update the admin api keys cache.
+func (r *CachedAdminKeysRegistry) startCacheUpdater() {
+	ticker := time.Tick(adminAPIKeysUpdateInterval)
+	go func() {
+		for {
+			select {
+			case <-ticker:
+				err := r.Refresh()
+				if err != nil {
+					r.logger.Sugar().Warnw("failed to update admin api keys registry", "err", err)
+				}
+			}
+		}
+	}()
+}
+
+// HasScope - returns whether the admin api key was granted the scope.
+func HasScope(adminAPIKey db_query.GetAdminAPIKeysRow, scope string) bool {
+	return slices.Contains(adminAPIKey.Scopes, scope)
+}
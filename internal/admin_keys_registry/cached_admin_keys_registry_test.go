@@ -0,0 +1,39 @@
+package admin_keys_registry
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	db_query_mock "github.com/pokt-network/gateway-server/mocks/db_query"
+	"github.com/pokt-network/gateway-server/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestGetAdminAPIKey(t *testing.T) {
+	mockQuerier := new(db_query_mock.Querier)
+	registry := &CachedAdminKeysRegistry{dbQuery: mockQuerier, adminAPIKeysCache: map[string]db_query.GetAdminAPIKeysRow{}, logger: zap.NewNop()}
+
+	_, ok := registry.GetAdminAPIKey("key")
+	assert.False(t, ok)
+
+	// Admin api keys are looked up by their hash
+	mockQuerier.EXPECT().GetAdminAPIKeys(mock.Anything).Return([]db_query.GetAdminAPIKeysRow{{ApiKeyHash: pgtype.Varchar{String: common.Sha256HashHex("key"), Status: pgtype.Present}, Scopes: []string{ScopeAppsRead}}}, nil).Once()
+	assert.NoError(t, registry.Refresh())
+	adminAPIKey, ok := registry.GetAdminAPIKey("key")
+	assert.True(t, ok)
+	assert.True(t, HasScope(adminAPIKey, ScopeAppsRead))
+	assert.False(t, HasScope(adminAPIKey, ScopeAppsWrite))
+
+	mockQuerier.EXPECT().GetAdminAPIKeys(mock.Anything).Return(nil, nil).Once()
+	assert.NoError(t, registry.Refresh())
+	_, ok = registry.GetAdminAPIKey("key")
+	assert.False(t, ok)
+	mockQuerier.AssertExpectations(t)
+}
+
+func TestIsValidScope(t *testing.T) {
+	assert.True(t, IsValidScope(ScopeChainsWrite))
+	assert.False(t, IsValidScope("chains:delete"))
+}
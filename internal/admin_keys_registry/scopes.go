@@ -0,0 +1,35 @@
+package admin_keys_registry
+
+import "slices"
+
+// Scopes granted to admin api keys, the root API_KEY is granted every scope.
+const (
+	ScopeAppsRead    = "apps:read"
+	ScopeAppsWrite   = "apps:write"
+	ScopeQosRead     = "qos:read"
+	ScopeChainsRead  = "chains:read"
+	ScopeChainsWrite = "chains:write"
+	ScopeUsersRead   = "users:read"
+	ScopeUsersWrite  = "users:write"
+	ScopeUsageRead   = "usage:read"
+	ScopeAdminRead   = "admin:read"
+	ScopeAdminWrite  = "admin:write"
+)
+
+var Scopes = []string{
+	ScopeAppsRead,
+	ScopeAppsWrite,
+	ScopeQosRead,
+	ScopeChainsRead,
+	ScopeChainsWrite,
+	ScopeUsersRead,
+	ScopeUsersWrite,
+	ScopeUsageRead,
+	ScopeAdminRead,
+	ScopeAdminWrite,
+}
+
+// IsValidScope - returns whether the scope can be granted to an admin api key.
+func IsValidScope(scope string) bool {
+	return slices.Contains(Scopes, scope)
+}
@@ -0,0 +1,40 @@
+package altruist_circuit_breaker
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrCircuitOpen is returned instead of sending a relay to the altruists of a chain while its circuit breaker is open
+var ErrCircuitOpen = errors.New("altruist circuit breaker is open")
+
+type State string
+
+const (
+	// StateClosed sends every failed relay to the altruists
+	StateClosed State = "closed"
+	// StateOpen fails relays without sending them to the altruists
+	StateOpen State = "open"
+	// StateHalfOpen only sends a few trial relays to the altruists, which close or re-open the circuit
+	StateHalfOpen State = "half_open"
+)
+
+type CircuitBreakerService interface {
+	// Allow - returns whether a relay can be sent to the altruists of the chain. Every allowed relay must be recorded or released.
+	Allow(chainId string) bool
+	// RecordResult - records whether a relay sent to the altruists of the chain succeeded and how long it took.
+	RecordResult(chainId string, success bool, latency time.Duration)
+	// Release - releases an allowed relay that was not sent to the altruists, such as when the chain has no altruists.
+	Release(chainId string)
+	GetCircuits() []Circuit
+}
+
+// Circuit is the state of the circuit breaker of a chain
+type Circuit struct {
+	ChainID string
+	State   State
+	// Calls and FailedCalls are the altruist relays within the window of a closed circuit
+	Calls       int
+	FailedCalls int
+	OpenedAt    time.Time
+}
@@ -0,0 +1,113 @@
+package altruist_circuit_breaker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	gaugeCircuitState       *prometheus.GaugeVec
+	counterCircuitRejection *prometheus.CounterVec
+)
+
+// circuitStateValues are the values of the altruist_circuit_breaker_state metric
+var circuitStateValues = map[State]float64{
+	StateClosed:   0,
+	StateHalfOpen: 1,
+	StateOpen:     2,
+}
+
+func init() {
+	gaugeCircuitState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "altruist_circuit_breaker_state",
+			Help: "State of the altruist circuit breaker of a chain, 0 is closed, 1 is half open and 2 is open",
+		},
+		[]string{"chain_id"},
+	)
+	counterCircuitRejection = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "altruist_circuit_breaker_rejected_counter",
+			Help: "Relays that were not sent to the altruists because the chain's circuit breaker was open",
+		},
+		[]string{"chain_id"},
+	)
+	prometheus.MustRegister(gaugeCircuitState, counterCircuitRejection)
+}
+
+// ChainCircuitBreakers keeps a circuit breaker for the altruists of every chain, so that a degraded network cannot
+// overwhelm the altruists with failed relays.
+type ChainCircuitBreakers struct {
+	circuitBreakers map[string]*circuitBreaker // chain id > circuit breaker
+	lock            sync.RWMutex
+	logger          *zap.Logger
+}
+
+func NewChainCircuitBreakers(logger *zap.Logger) *ChainCircuitBreakers {
+	return &ChainCircuitBreakers{circuitBreakers: map[string]*circuitBreaker{}, logger: logger}
+}
+
+func (c *ChainCircuitBreakers) Allow(chainId string) bool {
+	circuitBreaker := c.getCircuitBreaker(chainId)
+	allowed, changed := circuitBreaker.allow(time.Now())
+	if changed {
+		c.recordState(chainId, StateHalfOpen)
+	}
+	if !allowed {
+		counterCircuitRejection.WithLabelValues(chainId).Inc()
+	}
+	return allowed
+}
+
+func (c *ChainCircuitBreakers) RecordResult(chainId string, success bool, latency time.Duration) {
+	circuitBreaker := c.getCircuitBreaker(chainId)
+	now := time.Now()
+	if !circuitBreaker.record(now, success, latency) {
+		return
+	}
+	c.recordState(chainId, circuitBreaker.getCircuit(chainId, now).State)
+}
+
+func (c *ChainCircuitBreakers) Release(chainId string) {
+	c.getCircuitBreaker(chainId).release()
+}
+
+// GetCircuits - returns the circuit of every chain that relays were sent to the altruists for, ordered by chain id.
+func (c *ChainCircuitBreakers) GetCircuits() []Circuit {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	now := time.Now()
+	circuits := make([]Circuit, 0, len(c.circuitBreakers))
+	for chainId, circuitBreaker := range c.circuitBreakers {
+		circuits = append(circuits, circuitBreaker.getCircuit(chainId, now))
+	}
+	sort.Slice(circuits, func(i, j int) bool {
+		return circuits[i].ChainID < circuits[j].ChainID
+	})
+	return circuits
+}
+
+func (c *ChainCircuitBreakers) recordState(chainId string, state State) {
+	c.logger.Sugar().Warnw("altruist circuit breaker changed state", "chain", chainId, "state", state)
+	gaugeCircuitState.WithLabelValues(chainId).Set(circuitStateValues[state])
+}
+
+func (c *ChainCircuitBreakers) getCircuitBreaker(chainId string) *circuitBreaker {
+	c.lock.RLock()
+	circuitBreaker, ok := c.circuitBreakers[chainId]
+	c.lock.RUnlock()
+	if ok {
+		return circuitBreaker
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if circuitBreaker, ok = c.circuitBreakers[chainId]; !ok {
+		circuitBreaker = newCircuitBreaker()
+		c.circuitBreakers[chainId] = circuitBreaker
+	}
+	return circuitBreaker
+}
@@ -0,0 +1,140 @@
+package altruist_circuit_breaker
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// window of altruist relays that the failure rate of a closed circuit is calculated over
+	circuitWindow = time.Second * 30
+	// minimum amount of altruist relays within the window before a circuit can open
+	circuitMinCalls = 10
+	// ratio of failed or slow altruist relays within the window that opens a circuit
+	circuitFailureRateThreshold = 0.5
+	// altruist relays slower than this are counted as failures
+	circuitSlowCallThreshold = time.Second * 5
+	// duration a circuit stays open before trial relays are allowed
+	circuitOpenDuration = time.Second * 30
+	// amount of successful trial relays that close a half open circuit
+	circuitHalfOpenCalls = 3
+)
+
+type callResult struct {
+	time   time.Time
+	failed bool
+}
+
+// circuitBreaker is the circuit breaker of a single chain
+type circuitBreaker struct {
+	lock              sync.Mutex
+	state             State
+	results           []callResult
+	openedAt          time.Time
+	halfOpenInFlight  int
+	halfOpenSuccesses int
+}
+
+func newCircuitBreaker() *circuitBreaker {
+	return &circuitBreaker{state: StateClosed}
+}
+
+// allow - returns whether a relay can be sent to the altruists and whether the state of the circuit changed, since an
+// open circuit moves to half open once its open duration has passed.
+func (c *circuitBreaker) allow(now time.Time) (bool, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	changed := false
+	if c.state == StateOpen {
+		if now.Sub(c.openedAt) < circuitOpenDuration {
+			return false, false
+		}
+		c.state = StateHalfOpen
+		c.halfOpenInFlight = 0
+		c.halfOpenSuccesses = 0
+		changed = true
+	}
+	if c.state == StateHalfOpen {
+		if c.halfOpenInFlight+c.halfOpenSuccesses >= circuitHalfOpenCalls {
+			return false, changed
+		}
+		c.halfOpenInFlight++
+	}
+	return true, changed
+}
+
+// record - records the result of an allowed relay and returns whether the state of the circuit changed.
+func (c *circuitBreaker) record(now time.Time, success bool, latency time.Duration) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	failed := !success || latency > circuitSlowCallThreshold
+	switch c.state {
+	case StateClosed:
+		c.results = append(pruneResults(c.results, now), callResult{time: now, failed: failed})
+		calls, failedCalls := countResults(c.results)
+		if calls >= circuitMinCalls && float64(failedCalls)/float64(calls) >= circuitFailureRateThreshold {
+			c.open(now)
+			return true
+		}
+	case StateHalfOpen:
+		c.halfOpenInFlight = max(c.halfOpenInFlight-1, 0)
+		if failed {
+			c.open(now)
+			return true
+		}
+		c.halfOpenSuccesses++
+		if c.halfOpenSuccesses >= circuitHalfOpenCalls {
+			c.state = StateClosed
+			c.results = nil
+			return true
+		}
+	}
+	// Relays that were allowed before the circuit opened are ignored
+	return false
+}
+
+// release - frees the trial slot of an allowed relay that was not sent, so that a half open circuit keeps allowing trial relays.
+func (c *circuitBreaker) release() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.state == StateHalfOpen {
+		c.halfOpenInFlight = max(c.halfOpenInFlight-1, 0)
+	}
+}
+
+func (c *circuitBreaker) open(now time.Time) {
+	c.state = StateOpen
+	c.openedAt = now
+	c.results = nil
+}
+
+func (c *circuitBreaker) getCircuit(chainId string, now time.Time) Circuit {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	calls, failedCalls := countResults(pruneResults(c.results, now))
+	circuit := Circuit{ChainID: chainId, State: c.state, Calls: calls, FailedCalls: failedCalls}
+	if c.state != StateClosed {
+		circuit.OpenedAt = c.openedAt
+	}
+	return circuit
+}
+
+// pruneResults - removes the results that are older than the window, results are ordered by time.
+func pruneResults(results []callResult, now time.Time) []callResult {
+	for i, result := range results {
+		if now.Sub(result.time) <= circuitWindow {
+			return results[i:]
+		}
+	}
+	return nil
+}
+
+func countResults(results []callResult) (int, int) {
+	failedCalls := 0
+	for _, result := range results {
+		if result.failed {
+			failedCalls++
+		}
+	}
+	return len(results), failedCalls
+}
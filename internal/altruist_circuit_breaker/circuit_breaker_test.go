@@ -0,0 +1,104 @@
+package altruist_circuit_breaker
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerOpensOnFailureRate(t *testing.T) {
+	circuitBreaker := newCircuitBreaker()
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	// Failures below the minimum amount of calls do not open the circuit
+	for i := 0; i < circuitMinCalls-1; i++ {
+		allowed, _ := circuitBreaker.allow(now)
+		assert.True(t, allowed)
+		assert.False(t, circuitBreaker.record(now, false, time.Millisecond))
+	}
+	allowed, _ := circuitBreaker.allow(now)
+	assert.True(t, allowed)
+	assert.True(t, circuitBreaker.record(now, false, time.Millisecond))
+	assert.Equal(t, StateOpen, circuitBreaker.getCircuit("0021", now).State)
+
+	allowed, _ = circuitBreaker.allow(now.Add(circuitOpenDuration - time.Second))
+	assert.False(t, allowed)
+}
+
+func TestCircuitBreakerCountsSlowCalls(t *testing.T) {
+	circuitBreaker := newCircuitBreaker()
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < circuitMinCalls; i++ {
+		circuitBreaker.allow(now)
+		// Every other call succeeds, but slower than the threshold
+		circuitBreaker.record(now, true, circuitSlowCallThreshold*time.Duration(i%2*2))
+	}
+	assert.Equal(t, StateOpen, circuitBreaker.getCircuit("0021", now).State)
+}
+
+func TestCircuitBreakerIgnoresResultsOutsideWindow(t *testing.T) {
+	circuitBreaker := newCircuitBreaker()
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < circuitMinCalls-1; i++ {
+		circuitBreaker.allow(now)
+		circuitBreaker.record(now, false, time.Millisecond)
+	}
+	later := now.Add(circuitWindow + time.Second)
+	circuitBreaker.allow(later)
+	assert.False(t, circuitBreaker.record(later, false, time.Millisecond))
+
+	circuit := circuitBreaker.getCircuit("0021", later)
+	assert.Equal(t, StateClosed, circuit.State)
+	assert.Equal(t, 1, circuit.Calls)
+}
+
+func TestCircuitBreakerReleasesTrialSlots(t *testing.T) {
+	circuitBreaker := newCircuitBreaker()
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	circuitBreaker.open(now)
+
+	// Trial relays that are released instead of sent do not use up the half open circuit's trial slots
+	halfOpen := now.Add(circuitOpenDuration)
+	for i := 0; i < circuitHalfOpenCalls*2; i++ {
+		allowed, _ := circuitBreaker.allow(halfOpen)
+		assert.True(t, allowed)
+		circuitBreaker.release()
+	}
+	assert.Equal(t, StateHalfOpen, circuitBreaker.getCircuit("0021", halfOpen).State)
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	tests := []struct {
+		name          string
+		trialSuccess  bool
+		expectedState State
+	}{
+		{name: "successful trials close the circuit", trialSuccess: true, expectedState: StateClosed},
+		{name: "failed trial re-opens the circuit", trialSuccess: false, expectedState: StateOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			circuitBreaker := newCircuitBreaker()
+			now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+			circuitBreaker.open(now)
+
+			halfOpen := now.Add(circuitOpenDuration)
+			for i := 0; i < circuitHalfOpenCalls; i++ {
+				allowed, changed := circuitBreaker.allow(halfOpen)
+				assert.True(t, allowed)
+				assert.Equal(t, i == 0, changed)
+			}
+			// Only a limited amount of trial relays are allowed at the same time
+			allowed, _ := circuitBreaker.allow(halfOpen)
+			assert.False(t, allowed)
+			assert.Equal(t, StateHalfOpen, circuitBreaker.getCircuit("0021", halfOpen).State)
+
+			for i := 0; i < circuitHalfOpenCalls; i++ {
+				circuitBreaker.record(halfOpen, tt.trialSuccess, time.Millisecond)
+			}
+			assert.Equal(t, tt.expectedState, circuitBreaker.getCircuit("0021", halfOpen).State)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package altruist_registry
+
+import (
+	"fmt"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/bitcoin_height_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/cosmos_height_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/evm_height_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/near_height_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/pokt_height_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/solana_height_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/starknet_height_check"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/valyala/fasthttp"
+	"sync"
+	"time"
+)
+
+const (
+	// interval to probe the height of every altruist
+	altruistHealthCheckInterval = time.Second * 30
+	// timeout of a probe, unless the altruist has a request timeout
+	defaultAltruistHealthCheckTimeout = time.Second * 10
+	// number of blocks an altruist is allowed to be behind, unless the chain has a height check block tolerance
+	defaultAltruistHeightTolerance = 100
+)
+
+var (
+	gaugeAltruistHealthy *prometheus.GaugeVec
+)
+
+func init() {
+	gaugeAltruistHealthy = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "altruist_healthy",
+			Help: "Whether an altruist answered its last height probe and was not behind the other altruists of the chain",
+		},
+		[]string{"chain_id", "altruist_id"},
+	)
+	prometheus.MustRegister(gaugeAltruistHealthy)
+}
+
+type httpRequester interface {
+	DoTimeout(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error
+}
+
+type fastHttpRequester struct{}
+
+func (receiver fastHttpRequester) DoTimeout(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+	return fasthttp.DoTimeout(req, resp, timeout)
+}
+
+// heightProbe is the request used to retrieve the height of a chain, shared with the node height checks
+type heightProbe struct {
+	method      string
+	payload     string
+	path        string
+	parseHeight checks.HeightJsonParser
+}
+
+type altruistHeight struct {
+	altruist *Altruist
+	height   uint64
+	err      error
+}
+
+// startHealthChecker starts a goroutine to periodically probe the altruists.
+func (r *CachedAltruistRegistry) startHealthChecker() {
+	ticker := time.Tick(altruistHealthCheckInterval)
+	go func() {
+		for {
+			select {
+			case <-ticker:
+				r.checkAltruists()
+			}
+		}
+	}()
+}
+
+// checkAltruists - probes the height of every altruist. Altruists that fail the probe or are further behind the
+// highest altruist of their chain than the chain's block tolerance are skipped until their next successful probe.
+func (r *CachedAltruistRegistry) checkAltruists() {
+	var wg sync.WaitGroup
+	for chainId, altruists := range r.getAllAltruists() {
+		wg.Add(1)
+		go func(chainId string, altruists []*Altruist) {
+			defer wg.Done()
+			r.checkChainAltruists(chainId, altruists)
+		}(chainId, altruists)
+	}
+	wg.Wait()
+}
+
+func (r *CachedAltruistRegistry) checkChainAltruists(chainId string, altruists []*Altruist) {
+	probe, ok := r.getHeightProbe(chainId)
+	if !ok {
+		// Altruists of chains without a built-in height check are not probed and stay healthy
+		return
+	}
+	heights := make(chan altruistHeight, len(altruists))
+	for _, altruist := range altruists {
+		go func(altruist *Altruist) {
+			height, err := r.probeHeight(altruist, probe)
+			heights <- altruistHeight{altruist: altruist, height: height, err: err}
+		}(altruist)
+	}
+
+	var highestHeight uint64
+	results := make([]altruistHeight, 0, len(altruists))
+	for range altruists {
+		result := <-heights
+		results = append(results, result)
+		if result.err == nil {
+			highestHeight = max(highestHeight, result.height)
+		}
+	}
+
+	tolerance := uint64(checks.GetBlockHeightTolerance(r.chainConfigurationRegistry, chainId, defaultAltruistHeightTolerance))
+	for _, result := range results {
+		healthy := result.err == nil
+		if healthy && highestHeight-result.height > tolerance {
+			healthy = false
+			result.err = fmt.Errorf("heightDifference: %d, altruistHeight: %d, highestAltruistHeight: %d", highestHeight-result.height, result.height, highestHeight)
+		}
+		if !healthy {
+			r.logger.Sugar().Warnw("altruist is unhealthy", "chain", chainId, "altruist", result.altruist.ID, "err", result.err)
+		}
+		result.altruist.setHealth(healthy, result.height, result.err)
+		healthyValue := 0.0
+		if healthy {
+			healthyValue = 1
+		}
+		gaugeAltruistHealthy.WithLabelValues(chainId, result.altruist.ID).Set(healthyValue)
+	}
+}
+
+// probeHeight - sends the chain's height request to the altruist and parses its height.
+func (r *CachedAltruistRegistry) probeHeight(altruist *Altruist, probe heightProbe) (uint64, error) {
+	request := fasthttp.AcquireRequest()
+	response := fasthttp.AcquireResponse()
+	defer func() {
+		fasthttp.ReleaseRequest(request)
+		fasthttp.ReleaseResponse(response)
+	}()
+
+	request.SetRequestURI(altruist.Url + probe.path)
+	request.Header.SetMethod(probe.method)
+	request.Header.SetContentType("application/json")
+	for header, value := range altruist.Headers {
+		request.Header.Set(header, value)
+	}
+	request.SetBodyString(probe.payload)
+
+	timeout := defaultAltruistHealthCheckTimeout
+	if altruist.RequestTimeout > 0 {
+		timeout = altruist.RequestTimeout
+	}
+	if err := r.httpRequester.DoTimeout(request, response, timeout); err != nil {
+		return 0, err
+	}
+	if response.StatusCode() != fasthttp.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d", response.StatusCode())
+	}
+	return probe.parseHeight(string(response.Body()))
+}
+
+// getHeightProbe - returns the height request of the chain's family, chains of the none family cannot be probed.
+func (r *CachedAltruistRegistry) getHeightProbe(chainId string) (heightProbe, bool) {
+	switch checks.GetChainFamily(r.chainConfigurationRegistry, chainId) {
+	case checks.ChainFamilyPokt:
+		return heightProbe{method: fasthttp.MethodPost, payload: pokt_height_check.HeightJsonPayload, path: pokt_height_check.HeightPath, parseHeight: pokt_height_check.ParseHeight}, true
+	case checks.ChainFamilySolana:
+		return heightProbe{method: fasthttp.MethodPost, payload: solana_height_check.HeightJsonPayload, parseHeight: solana_height_check.ParseHeight}, true
+	case checks.ChainFamilyCosmos:
+		return heightProbe{method: fasthttp.MethodGet, path: cosmos_height_check.HeightPath, parseHeight: cosmos_height_check.ParseHeight}, true
+	case checks.ChainFamilyCosmosLcd:
+		return heightProbe{method: fasthttp.MethodGet, path: cosmos_height_check.LcdHeightPath, parseHeight: cosmos_height_check.ParseLcdHeight}, true
+	case checks.ChainFamilyNear:
+		return heightProbe{method: fasthttp.MethodPost, payload: near_height_check.HeightJsonPayload, parseHeight: near_height_check.ParseHeight}, true
+	case checks.ChainFamilyBitcoin:
+		return heightProbe{method: fasthttp.MethodPost, payload: bitcoin_height_check.HeightJsonPayload, parseHeight: bitcoin_height_check.ParseHeight}, true
+	case checks.ChainFamilyStarknet:
+		return heightProbe{method: fasthttp.MethodPost, payload: starknet_height_check.HeightJsonPayload, parseHeight: starknet_height_check.ParseHeight}, true
+	case checks.ChainFamilyNone:
+		return heightProbe{}, false
+	default:
+		return heightProbe{method: fasthttp.MethodPost, payload: evm_height_check.HeightJsonPayload, parseHeight: evm_height_check.ParseHeight}, true
+	}
+}
@@ -0,0 +1,66 @@
+package altruist_registry
+
+import (
+	"sync"
+	"time"
+)
+
+type AltruistRegistryService interface {
+	// GetAltruists - returns the healthy altruists of a chain, in the order they should be attempted.
+	GetAltruists(chainId string) []*Altruist
+	Refresh() error
+}
+
+// Altruist is a fallback backend of a chain, which relays are sent to when the network fails.
+type Altruist struct {
+	ID      string
+	ChainID string
+	Url     string
+	Weight  int
+	// Headers are set on every request to the altruist, such as authorization headers
+	Headers map[string]string
+	// RequestTimeout is zero if the chain's altruist request timeout applies
+	RequestTimeout time.Duration
+	health         *altruistHealth
+}
+
+// altruistHealth is kept across registry refreshes, as long as the altruist's url is the same.
+type altruistHealth struct {
+	lock            sync.RWMutex
+	healthy         bool
+	lastKnownHeight uint64
+	lastError       error
+	lastCheckTime   time.Time
+}
+
+func newAltruistHealth() *altruistHealth {
+	// Altruists are healthy until they are probed
+	return &altruistHealth{healthy: true}
+}
+
+func (a *Altruist) IsHealthy() bool {
+	a.health.lock.RLock()
+	defer a.health.lock.RUnlock()
+	return a.health.healthy
+}
+
+func (a *Altruist) GetLastKnownHeight() uint64 {
+	a.health.lock.RLock()
+	defer a.health.lock.RUnlock()
+	return a.health.lastKnownHeight
+}
+
+func (a *Altruist) GetLastError() error {
+	a.health.lock.RLock()
+	defer a.health.lock.RUnlock()
+	return a.health.lastError
+}
+
+func (a *Altruist) setHealth(healthy bool, height uint64, err error) {
+	a.health.lock.Lock()
+	defer a.health.lock.Unlock()
+	a.health.healthy = healthy
+	a.health.lastKnownHeight = height
+	a.health.lastError = err
+	a.health.lastCheckTime = time.Now()
+}
@@ -0,0 +1,181 @@
+package altruist_registry
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pokt-network/gateway-server/internal/chain_configurations_registry"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"go.uber.org/zap"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+const (
+	altruistsUpdateInterval = time.Minute * 1
+)
+
+type CachedAltruistRegistry struct {
+	dbQuery                    db_query.Querier
+	chainConfigurationRegistry chain_configurations_registry.ChainConfigurationsService
+	httpRequester              httpRequester
+	altruistsCache             map[string][]*Altruist // chain id > altruists
+	cacheLock                  sync.RWMutex
+	logger                     *zap.Logger
+}
+
+func NewCachedAltruistRegistry(dbQuery db_query.Querier, chainConfigurationRegistry chain_configurations_registry.ChainConfigurationsService, logger *zap.Logger) *CachedAltruistRegistry {
+	altruistRegistry := &CachedAltruistRegistry{
+		dbQuery:                    dbQuery,
+		chainConfigurationRegistry: chainConfigurationRegistry,
+		httpRequester:              fastHttpRequester{},
+		altruistsCache:             map[string][]*Altruist{},
+		logger:                     logger,
+	}
+	err := altruistRegistry.Refresh()
+	if err != nil {
+		altruistRegistry.logger.Sugar().Warnw("Failed to retrieve altruists on startup", "err", err)
+	}
+	altruistRegistry.startCacheUpdater()
+	altruistRegistry.startHealthChecker()
+	return altruistRegistry
+}
+
+// GetAltruists - returns the healthy altruists of the chain in a random order weighted by the altruists' weight.
+// If every altruist is unhealthy, all of them are returned since failing the relay outright is never better.
+func (r *CachedAltruistRegistry) GetAltruists(chainId string) []*Altruist {
+	r.cacheLock.RLock()
+	altruists := r.altruistsCache[chainId]
+	r.cacheLock.RUnlock()
+
+	var healthyAltruists []*Altruist
+	for _, altruist := range altruists {
+		if altruist.IsHealthy() {
+			healthyAltruists = append(healthyAltruists, altruist)
+		}
+	}
+	if len(healthyAltruists) == 0 {
+		healthyAltruists = append(healthyAltruists, altruists...)
+	}
+	return weightedShuffle(healthyAltruists)
+}
+
+// Refresh - reloads the altruists of chain_altruists. Chains without altruists in chain_altruists keep using the
+// altruist_url of their chain configuration.
+func (r *CachedAltruistRegistry) Refresh() error {
+	chainAltruists, err := r.dbQuery.GetChainAltruists(context.Background())
+	if err != nil {
+		return err
+	}
+	chainConfigs, err := r.dbQuery.GetChainConfigurations(context.Background())
+	if err != nil {
+		return err
+	}
+
+	altruistsNew := map[string][]*Altruist{}
+	for _, row := range chainAltruists {
+		altruist, err := newChainAltruist(row)
+		if err != nil {
+			r.logger.Sugar().Warnw("invalid chain altruist", "chain", row.ChainID.String, "err", err)
+			continue
+		}
+		altruistsNew[altruist.ChainID] = append(altruistsNew[altruist.ChainID], altruist)
+	}
+	for _, chainConfig := range chainConfigs {
+		chainId := chainConfig.ChainID.String
+		if _, ok := altruistsNew[chainId]; ok || chainConfig.AltruistUrl.String == "" {
+			continue
+		}
+		id, _ := chainConfig.ID.Value()
+		altruistId, _ := id.(string)
+		altruistsNew[chainId] = []*Altruist{{ID: altruistId, ChainID: chainId, Url: chainConfig.AltruistUrl.String, Weight: 1}}
+	}
+
+	// Update the cache
+	r.cacheLock.Lock()
+	defer r.cacheLock.Unlock()
+	for chainId, altruists := range altruistsNew {
+		for _, altruist := range altruists {
+			altruist.health = r.getAltruistHealth(chainId, altruist)
+		}
+	}
+	r.altruistsCache = altruistsNew
+	return nil
+}
+
+// getAltruistHealth - returns the health of the cached altruist with the same id and url, so that refreshes do not
+// reset the health checks. Must be called with the cache lock held.
+func (r *CachedAltruistRegistry) getAltruistHealth(chainId string, altruist *Altruist) *altruistHealth {
+	for _, cachedAltruist := range r.altruistsCache[chainId] {
+		if cachedAltruist.ID == altruist.ID && cachedAltruist.Url == altruist.Url {
+			return cachedAltruist.health
+		}
+	}
+	return newAltruistHealth()
+}
+
+func (r *CachedAltruistRegistry) getAllAltruists() map[string][]*Altruist {
+	r.cacheLock.RLock()
+	defer r.cacheLock.RUnlock()
+	return r.altruistsCache
+}
+
+// startCacheUpdater starts a goroutine to periodically update the altruists cache.
+func (r *CachedAltruistRegistry) startCacheUpdater() {
+	ticker := time.Tick(altruistsUpdateInterval)
+	go func() {
+		for {
+			select {
+			case <-ticker:
+				err := r.Refresh()
+				if err != nil {
+					r.logger.Sugar().Warnw("failed to update altruist registry", "err", err)
+				}
+			}
+		}
+	}()
+}
+
+func newChainAltruist(row db_query.GetChainAltruistsRow) (*Altruist, error) {
+	id, _ := row.ID.Value()
+	altruistId, _ := id.(string)
+	altruist := &Altruist{ID: altruistId, ChainID: row.ChainID.String, Url: row.Url.String, Weight: 1}
+	if row.Weight != nil && *row.Weight > 0 {
+		altruist.Weight = int(*row.Weight)
+	}
+	if row.AuthHeaders.String != "" {
+		if err := json.Unmarshal([]byte(row.AuthHeaders.String), &altruist.Headers); err != nil {
+			return nil, err
+		}
+	}
+	if row.RequestTimeoutDuration.String != "" {
+		requestTimeout, err := time.ParseDuration(row.RequestTimeoutDuration.String)
+		if err != nil {
+			return nil, err
+		}
+		altruist.RequestTimeout = requestTimeout
+	}
+	return altruist, nil
+}
+
+// weightedShuffle - orders the altruists by picking each next altruist with a probability proportional to its weight.
+func weightedShuffle(altruists []*Altruist) []*Altruist {
+	remaining := append([]*Altruist{}, altruists...)
+	ordered := make([]*Altruist, 0, len(altruists))
+	for len(remaining) > 0 {
+		totalWeight := 0
+		for _, altruist := range remaining {
+			totalWeight += altruist.Weight
+		}
+		pick := rand.Intn(totalWeight)
+		for i, altruist := range remaining {
+			pick -= altruist.Weight
+			if pick < 0 {
+				ordered = append(ordered, altruist)
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+	return ordered
+}
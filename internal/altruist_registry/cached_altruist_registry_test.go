@@ -0,0 +1,144 @@
+package altruist_registry
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	chain_configurations_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_configurations_registry"
+	db_query_mock "github.com/pokt-network/gateway-server/mocks/db_query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+// httpRequesterFunc allows a function to be used as the registry's http requester
+type httpRequesterFunc func(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error
+
+func (f httpRequesterFunc) DoTimeout(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+	return f(req, resp, timeout)
+}
+
+func varchar(value string) pgtype.Varchar {
+	return pgtype.Varchar{String: value, Status: pgtype.Present}
+}
+
+func uuid(value byte) pgtype.UUID {
+	return pgtype.UUID{Bytes: [16]byte{value}, Status: pgtype.Present}
+}
+
+func newTestRegistry(mockQuerier *db_query_mock.Querier) *CachedAltruistRegistry {
+	chainConfigurationRegistry := new(chain_configurations_registry_mock.ChainConfigurationsService)
+	heightTolerance := int32(100)
+	chainConfigurationRegistry.EXPECT().GetChainConfiguration("0021").Return(db_query.GetChainConfigurationsRow{ChainFamily: varchar("evm"), HeightCheckBlockTolerance: &heightTolerance}, true).Maybe()
+	return &CachedAltruistRegistry{
+		dbQuery:                    mockQuerier,
+		chainConfigurationRegistry: chainConfigurationRegistry,
+		altruistsCache:             map[string][]*Altruist{},
+		logger:                     zap.NewNop(),
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	weight := int32(3)
+	mockQuerier := new(db_query_mock.Querier)
+	mockQuerier.EXPECT().GetChainAltruists(mock.Anything).Return([]db_query.GetChainAltruistsRow{
+		{ID: uuid(1), ChainID: varchar("0021"), Url: varchar("https://first.com"), Weight: &weight, AuthHeaders: varchar(`{"Authorization":"Bearer token"}`), RequestTimeoutDuration: varchar("5s")},
+		{ID: uuid(2), ChainID: varchar("0021"), Url: varchar("https://invalid.com"), AuthHeaders: varchar("not json")},
+	}, nil)
+	mockQuerier.EXPECT().GetChainConfigurations(mock.Anything).Return([]db_query.GetChainConfigurationsRow{
+		// Chains with chain altruists do not use the altruist url of their configuration
+		{ID: uuid(3), ChainID: varchar("0021"), AltruistUrl: varchar("https://ignored.com")},
+		{ID: uuid(4), ChainID: varchar("0001"), AltruistUrl: varchar("https://pokt.com")},
+	}, nil)
+	registry := newTestRegistry(mockQuerier)
+	assert.NoError(t, registry.Refresh())
+
+	altruists := registry.GetAltruists("0021")
+	assert.Len(t, altruists, 1)
+	assert.Equal(t, "https://first.com", altruists[0].Url)
+	assert.Equal(t, 3, altruists[0].Weight)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer token"}, altruists[0].Headers)
+	assert.Equal(t, time.Second*5, altruists[0].RequestTimeout)
+
+	altruists = registry.GetAltruists("0001")
+	assert.Len(t, altruists, 1)
+	assert.Equal(t, "https://pokt.com", altruists[0].Url)
+
+	// Health is kept across refreshes
+	altruists[0].setHealth(false, 0, nil)
+	assert.NoError(t, registry.Refresh())
+	assert.False(t, registry.GetAltruists("0001")[0].IsHealthy())
+}
+
+func TestGetAltruistsSkipsUnhealthyAltruists(t *testing.T) {
+	registry := newTestRegistry(new(db_query_mock.Querier))
+	healthy := &Altruist{Url: "https://healthy.com", Weight: 1, health: newAltruistHealth()}
+	unhealthy := &Altruist{Url: "https://unhealthy.com", Weight: 1, health: newAltruistHealth()}
+	unhealthy.setHealth(false, 0, nil)
+	registry.altruistsCache = map[string][]*Altruist{"0021": {healthy, unhealthy}}
+
+	assert.Equal(t, []*Altruist{healthy}, registry.GetAltruists("0021"))
+
+	// Every altruist is attempted when none of them is healthy
+	healthy.setHealth(false, 0, nil)
+	assert.Len(t, registry.GetAltruists("0021"), 2)
+}
+
+func TestCheckChainAltruists(t *testing.T) {
+	registry := newTestRegistry(new(db_query_mock.Querier))
+	synced := &Altruist{ID: "synced", Url: "https://synced.com", Weight: 1, health: newAltruistHealth()}
+	lagging := &Altruist{ID: "lagging", Url: "https://lagging.com", Weight: 1, health: newAltruistHealth()}
+	failing := &Altruist{ID: "failing", Url: "https://failing.com", Weight: 1, health: newAltruistHealth()}
+	registry.httpRequester = httpRequesterFunc(func(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+		switch string(req.Host()) {
+		case "synced.com":
+			resp.SetBodyString(`{"jsonrpc":"2.0","id":1,"result":"0x3e8"}`)
+		case "lagging.com":
+			resp.SetBodyString(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+		default:
+			resp.SetStatusCode(fasthttp.StatusServiceUnavailable)
+		}
+		return nil
+	})
+
+	registry.checkChainAltruists("0021", []*Altruist{synced, lagging, failing})
+
+	assert.True(t, synced.IsHealthy())
+	assert.Equal(t, uint64(1000), synced.GetLastKnownHeight())
+	assert.False(t, lagging.IsHealthy())
+	assert.False(t, failing.IsHealthy())
+	assert.Error(t, failing.GetLastError())
+}
+
+func TestCheckChainAltruistsSkipsChainsWithoutChecks(t *testing.T) {
+	registry := newTestRegistry(new(db_query_mock.Querier))
+	chainConfigurationRegistry := new(chain_configurations_registry_mock.ChainConfigurationsService)
+	chainConfigurationRegistry.EXPECT().GetChainConfiguration("0027").Return(db_query.GetChainConfigurationsRow{ChainFamily: varchar("none")}, true)
+	registry.chainConfigurationRegistry = chainConfigurationRegistry
+	registry.httpRequester = httpRequesterFunc(func(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+		t.Fatal("altruists of chains without checks should not be probed")
+		return nil
+	})
+	altruist := &Altruist{ID: "altruist", Url: "https://altruist.com", Weight: 1, health: newAltruistHealth()}
+
+	registry.checkChainAltruists("0027", []*Altruist{altruist})
+
+	assert.True(t, altruist.IsHealthy())
+}
+
+func TestWeightedShuffle(t *testing.T) {
+	heavy := &Altruist{Url: "https://heavy.com", Weight: 99}
+	light := &Altruist{Url: "https://light.com", Weight: 1}
+
+	heavyFirst := 0
+	for i := 0; i < 1000; i++ {
+		altruists := weightedShuffle([]*Altruist{light, heavy})
+		assert.Len(t, altruists, 2)
+		if altruists[0] == heavy {
+			heavyFirst++
+		}
+	}
+	assert.Greater(t, heavyFirst, 900)
+}
@@ -0,0 +1,133 @@
+package chain_checks_registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/pkg/common"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+const (
+	chainChecksUpdateInterval = time.Minute * 1
+)
+
+type CachedChainChecksRegistry struct {
+	dbQuery          db_query.Querier
+	chainChecksCache map[string][]*ChainCheck // chain id > checks
+	cacheLock        sync.RWMutex
+	logger           *zap.Logger
+}
+
+func NewCachedChainChecksRegistry(dbQuery db_query.Querier, logger *zap.Logger) *CachedChainChecksRegistry {
+	chainChecksRegistry := &CachedChainChecksRegistry{dbQuery: dbQuery, chainChecksCache: map[string][]*ChainCheck{}, logger: logger}
+	err := chainChecksRegistry.Refresh()
+	if err != nil {
+		chainChecksRegistry.logger.Sugar().Warnw("Failed to retrieve chain checks on startup", "err", err)
+	}
+	chainChecksRegistry.startCacheUpdater()
+	return chainChecksRegistry
+}
+
+func (r *CachedChainChecksRegistry) GetChainChecks(chainId string) []*ChainCheck {
+	r.cacheLock.RLock()
+	defer r.cacheLock.RUnlock()
+	return r.chainChecksCache[chainId]
+}
+
+// Refresh - reloads the checks of chain_checks. Invalid checks are logged and skipped, since they are inserted with SQL.
+func (r *CachedChainChecksRegistry) Refresh() error {
+	chainChecks, err := r.dbQuery.GetChainChecks(context.Background())
+	if err != nil {
+		return err
+	}
+
+	chainChecksNew := map[string][]*ChainCheck{}
+	for _, row := range chainChecks {
+		chainCheck, err := newChainCheck(row)
+		if err != nil {
+			r.logger.Sugar().Warnw("invalid chain check", "chain", row.ChainID.String, "name", row.Name.String, "err", err)
+			continue
+		}
+		chainChecksNew[chainCheck.ChainID] = append(chainChecksNew[chainCheck.ChainID], chainCheck)
+	}
+
+	// Update the cache
+	r.cacheLock.Lock()
+	defer r.cacheLock.Unlock()
+	r.chainChecksCache = chainChecksNew
+	return nil
+}
+
+// startCacheUpdater starts a goroutine to periodically update the chain checks cache.
+func (r *CachedChainChecksRegistry) startCacheUpdater() {
+	ticker := time.Tick(chainChecksUpdateInterval)
+	go func() {
+		for {
+			select {
+			case <-ticker:
+				err := r.Refresh()
+				if err != nil {
+					r.logger.Sugar().Warnw("failed to update chain checks registry", "err", err)
+				}
+			}
+		}
+	}()
+}
+
+func newChainCheck(row db_query.GetChainChecksRow) (*ChainCheck, error) {
+	id, _ := row.ID.Value()
+	checkId, _ := id.(string)
+	chainCheck := &ChainCheck{
+		ID:            checkId,
+		ChainID:       row.ChainID.String,
+		Name:          row.Name.String,
+		Method:        row.Method.String,
+		Path:          row.Path.String,
+		Payload:       row.Payload.String,
+		Comparison:    Comparison(row.Comparison.String),
+		ExpectedValue: row.ExpectedValue.String,
+	}
+	if chainCheck.Method == "" {
+		chainCheck.Method = "POST"
+	}
+	jsonPath, err := common.ParseJsonPath(row.JsonPath.String)
+	if err != nil {
+		return nil, err
+	}
+	chainCheck.JsonPath = jsonPath
+	switch chainCheck.Comparison {
+	case ComparisonHeightTolerance, ComparisonMajority:
+	case ComparisonExpectedValue:
+		if row.ExpectedValue.String == "" {
+			return nil, errors.New("missing expected value")
+		}
+	default:
+		return nil, fmt.Errorf("unknown comparison %s", chainCheck.Comparison)
+	}
+	if row.HeightTolerance != nil {
+		heightTolerance := int(*row.HeightTolerance)
+		chainCheck.HeightTolerance = &heightTolerance
+	}
+	if chainCheck.Interval, err = parsePositiveDuration(row.IntervalDuration.String); err != nil {
+		return nil, fmt.Errorf("invalid interval: %w", err)
+	}
+	if chainCheck.Penalty, err = parsePositiveDuration(row.PenaltyDuration.String); err != nil {
+		return nil, fmt.Errorf("invalid penalty: %w", err)
+	}
+	return chainCheck, nil
+}
+
+func parsePositiveDuration(duration string) (time.Duration, error) {
+	parsedDuration, err := time.ParseDuration(duration)
+	if err != nil {
+		return 0, err
+	}
+	if parsedDuration <= 0 {
+		return 0, fmt.Errorf("duration %s must be positive", duration)
+	}
+	return parsedDuration, nil
+}
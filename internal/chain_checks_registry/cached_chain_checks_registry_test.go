@@ -0,0 +1,61 @@
+package chain_checks_registry
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	db_query_mock "github.com/pokt-network/gateway-server/mocks/db_query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func varchar(value string) pgtype.Varchar {
+	return pgtype.Varchar{String: value, Status: pgtype.Present}
+}
+
+func TestRefresh(t *testing.T) {
+	heightTolerance := int32(10)
+	newRow := func(name string, jsonPath string, comparison string, expectedValue string, interval string) db_query.GetChainChecksRow {
+		return db_query.GetChainChecksRow{
+			ChainID:          varchar("0027"),
+			Name:             varchar(name),
+			Path:             varchar("/status"),
+			JsonPath:         varchar(jsonPath),
+			Comparison:       varchar(comparison),
+			ExpectedValue:    varchar(expectedValue),
+			IntervalDuration: varchar(interval),
+			PenaltyDuration:  varchar("10m"),
+		}
+	}
+	heightRow := newRow("height", "$.result.sync_info.latest_block_height", "height_tolerance", "", "30s")
+	heightRow.HeightTolerance = &heightTolerance
+	mockQuerier := new(db_query_mock.Querier)
+	mockQuerier.EXPECT().GetChainChecks(mock.Anything).Return([]db_query.GetChainChecksRow{
+		heightRow,
+		newRow("network", "$.result.node_info.network", "expected_value", "cosmoshub-4", "1h"),
+		newRow("invalid json path", "result.node_info", "majority", "", "1h"),
+		newRow("missing expected value", "$.result.node_info.network", "expected_value", "", "1h"),
+		newRow("unknown comparison", "$.result", "lowest", "", "1h"),
+		newRow("negative interval", "$.result", "majority", "", "-1h"),
+	}, nil)
+	registry := &CachedChainChecksRegistry{
+		dbQuery:          mockQuerier,
+		chainChecksCache: map[string][]*ChainCheck{},
+		logger:           zap.NewNop(),
+	}
+	assert.NoError(t, registry.Refresh())
+
+	chainChecks := registry.GetChainChecks("0027")
+	assert.Len(t, chainChecks, 2)
+	assert.Equal(t, "height", chainChecks[0].Name)
+	assert.Equal(t, "POST", chainChecks[0].Method)
+	assert.Equal(t, ComparisonHeightTolerance, chainChecks[0].Comparison)
+	assert.Equal(t, 10, *chainChecks[0].HeightTolerance)
+	assert.Equal(t, time.Second*30, chainChecks[0].Interval)
+	assert.Equal(t, time.Minute*10, chainChecks[0].Penalty)
+	assert.Equal(t, "network", chainChecks[1].Name)
+	assert.Nil(t, chainChecks[1].HeightTolerance)
+	assert.Empty(t, registry.GetChainChecks("0021"))
+}
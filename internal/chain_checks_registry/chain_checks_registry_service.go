@@ -0,0 +1,41 @@
+package chain_checks_registry
+
+import (
+	"github.com/pokt-network/gateway-server/pkg/common"
+	"time"
+)
+
+type Comparison string
+
+const (
+	// ComparisonHeightTolerance - the value is a block height, nodes further behind the highest node than the tolerance are out of sync
+	ComparisonHeightTolerance Comparison = "height_tolerance"
+	// ComparisonMajority - nodes whose value differs from the value most nodes agree on are punished
+	ComparisonMajority Comparison = "majority"
+	// ComparisonExpectedValue - nodes whose value differs from the check's expected value are punished
+	ComparisonExpectedValue Comparison = "expected_value"
+)
+
+type ChainChecksService interface {
+	// GetChainChecks - returns the custom QoS checks of a chain.
+	GetChainChecks(chainId string) []*ChainCheck
+	Refresh() error
+}
+
+// ChainCheck is a custom QoS check of a chain defined in the chain_checks table.
+type ChainCheck struct {
+	ID      string
+	ChainID string
+	Name    string
+	Method  string
+	Path    string
+	Payload string
+	// JsonPath extracts the height or value compared across nodes from the nodes' responses
+	JsonPath      common.JsonPath
+	Comparison    Comparison
+	ExpectedValue string
+	// HeightTolerance is nil if the chain's height check block tolerance applies
+	HeightTolerance *int
+	Interval        time.Duration
+	Penalty         time.Duration
+}
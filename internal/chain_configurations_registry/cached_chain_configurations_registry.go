@@ -21,7 +21,7 @@ type CachedChainConfigurationRegistry struct {
 
 func NewCachedChainConfigurationRegistry(dbQuery db_query.Querier, logger *zap.Logger) *CachedChainConfigurationRegistry {
 	chainConfigurationRegistry := &CachedChainConfigurationRegistry{dbQuery: dbQuery, chainConfigurationCache: map[string]db_query.GetChainConfigurationsRow{}, logger: logger}
-	err := chainConfigurationRegistry.updateChainConfigurations()
+	err := chainConfigurationRegistry.Refresh()
 	if err != nil {
 		chainConfigurationRegistry.logger.Sugar().Warnw("Failed to retrieve chain global_config on startup", "err", err)
 	}
@@ -36,7 +36,7 @@ func (r *CachedChainConfigurationRegistry) GetChainConfiguration(chainId string)
 	return url, found
 }
 
-func (r *CachedChainConfigurationRegistry) updateChainConfigurations() error {
+func (r *CachedChainConfigurationRegistry) Refresh() error {
 	chainConfigurations, err := r.dbQuery.GetChainConfigurations(context.Background())
 
 	if err != nil {
@@ -63,7 +63,7 @@ func (c *CachedChainConfigurationRegistry) startCacheUpdater() {
 			select {
 			case <-ticker:
 				// Call the updateChainConfigurations method
-				err := c.updateChainConfigurations()
+				err := c.Refresh()
 				if err != nil {
 					c.logger.Sugar().Warnw("failed to update chain configuration registry", "err", err)
 				} else {
@@ -1,8 +0,0 @@
-package chain_network
-
-type ChainNetwork string
-
-const (
-	MorseMainnet ChainNetwork = "morse_mainnet"
-	MorseTestnet ChainNetwork = "morse_testnet"
-)
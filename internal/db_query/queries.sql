@@ -11,4 +11,122 @@ DELETE FROM pokt_applications
 WHERE id = pggen.arg('application_id');
 
 -- name: GetChainConfigurations :many
-SELECT * FROM chain_configurations;
\ No newline at end of file
+SELECT * FROM chain_configurations;
+
+-- name: InsertChainConfiguration :one
+INSERT INTO chain_configurations (chain_id, pocket_request_timeout_duration, altruist_url, altruist_request_timeout_duration, top_bucket_p90latency_duration, height_check_block_tolerance, data_integrity_check_lookback_height, node_selection_strategy, relay_max_attempts, relay_retry_deadline_duration, relay_hedge_delay_duration, batch_relay_mode, altruist_websocket_url, relay_cache_ttls, relay_validation_sample_rate, evm_chain_id, archival_check_block_height, chain_family)
+VALUES (pggen.arg('chain_id'), pggen.arg('pocket_request_timeout_duration'), pggen.arg('altruist_url'), pggen.arg('altruist_request_timeout_duration'), pggen.arg('top_bucket_p90latency_duration'), pggen.arg('height_check_block_tolerance'), pggen.arg('data_integrity_check_lookback_height'), NULLIF(pggen.arg('node_selection_strategy'), ''), NULLIF(pggen.arg('relay_max_attempts'), 0), NULLIF(pggen.arg('relay_retry_deadline_duration'), ''), NULLIF(pggen.arg('relay_hedge_delay_duration'), ''), NULLIF(pggen.arg('batch_relay_mode'), ''), NULLIF(pggen.arg('altruist_websocket_url'), ''), NULLIF(pggen.arg('relay_cache_ttls'), ''), NULLIF(pggen.arg('relay_validation_sample_rate'), 0), NULLIF(pggen.arg('evm_chain_id'), ''), NULLIF(pggen.arg('archival_check_block_height'), 0), NULLIF(pggen.arg('chain_family'), ''))
+RETURNING id;
+
+-- name: UpdateChainConfiguration :exec
+UPDATE chain_configurations
+SET pocket_request_timeout_duration = pggen.arg('pocket_request_timeout_duration'), altruist_url = pggen.arg('altruist_url'), altruist_request_timeout_duration = pggen.arg('altruist_request_timeout_duration'), top_bucket_p90latency_duration = pggen.arg('top_bucket_p90latency_duration'), height_check_block_tolerance = pggen.arg('height_check_block_tolerance'), data_integrity_check_lookback_height = pggen.arg('data_integrity_check_lookback_height'), node_selection_strategy = NULLIF(pggen.arg('node_selection_strategy'), ''), relay_max_attempts = NULLIF(pggen.arg('relay_max_attempts'), 0), relay_retry_deadline_duration = NULLIF(pggen.arg('relay_retry_deadline_duration'), ''), relay_hedge_delay_duration = NULLIF(pggen.arg('relay_hedge_delay_duration'), ''), batch_relay_mode = NULLIF(pggen.arg('batch_relay_mode'), ''), altruist_websocket_url = NULLIF(pggen.arg('altruist_websocket_url'), ''), relay_cache_ttls = NULLIF(pggen.arg('relay_cache_ttls'), ''), relay_validation_sample_rate = NULLIF(pggen.arg('relay_validation_sample_rate'), 0), evm_chain_id = NULLIF(pggen.arg('evm_chain_id'), ''), archival_check_block_height = NULLIF(pggen.arg('archival_check_block_height'), 0), chain_family = NULLIF(pggen.arg('chain_family'), ''), updated_at = NOW()
+WHERE chain_id = pggen.arg('chain_id');
+
+-- name: DeleteChainConfiguration :exec
+DELETE FROM chain_configurations
+WHERE chain_id = pggen.arg('chain_id');
+
+-- name: GetChainAltruists :many
+SELECT id, chain_id, url, weight, auth_headers, request_timeout_duration
+FROM chain_altruists
+WHERE deleted_at IS NULL;
+
+-- name: GetChainChecks :many
+SELECT id, chain_id, name, method, path, payload, json_path, comparison, expected_value, height_tolerance, interval_duration, penalty_duration
+FROM chain_checks
+WHERE deleted_at IS NULL;
+
+-- name: GetRelayRateLimits :many
+SELECT api_key_hash, requests_per_second, burst, daily_relay_quota, monthly_relay_quota
+FROM relay_rate_limits
+WHERE deleted_at IS NULL;
+
+-- name: GetRelayQuotaUsage :many
+SELECT api_key_hash, period, relay_count
+FROM relay_quota_usage
+WHERE (period = 'day' AND period_start = pggen.arg('day_start'))
+   OR (period = 'month' AND period_start = pggen.arg('month_start'));
+
+-- name: IncrementRelayQuotaUsage :exec
+INSERT INTO relay_quota_usage (api_key_hash, period, period_start, relay_count)
+VALUES (pggen.arg('api_key_hash'), pggen.arg('period'), pggen.arg('period_start'), pggen.arg('relay_count'))
+ON CONFLICT (api_key_hash, period, period_start)
+DO UPDATE SET relay_count = relay_quota_usage.relay_count + EXCLUDED.relay_count;
+
+-- name: GetGatewayUsers :many
+SELECT id, email, name
+FROM gateway_users
+WHERE deleted_at IS NULL;
+
+-- name: InsertGatewayUser :one
+INSERT INTO gateway_users (email, name)
+VALUES (pggen.arg('email'), pggen.arg('name'))
+RETURNING id;
+
+-- name: DeleteGatewayUser :exec
+DELETE FROM gateway_users
+WHERE id = pggen.arg('user_id');
+
+-- name: GetGatewayEndpoints :many
+SELECT id, user_id, name, api_key_hash, allowed_chains, enabled
+FROM gateway_endpoints
+WHERE deleted_at IS NULL;
+
+-- name: InsertGatewayEndpoint :one
+INSERT INTO gateway_endpoints (user_id, name, api_key_hash, allowed_chains, enabled)
+VALUES (pggen.arg('user_id'), pggen.arg('name'), pggen.arg('api_key_hash'), pggen.arg('allowed_chains'), pggen.arg('enabled'))
+RETURNING id;
+
+-- name: UpdateGatewayEndpoint :exec
+UPDATE gateway_endpoints
+SET name = pggen.arg('name'), allowed_chains = pggen.arg('allowed_chains'), enabled = pggen.arg('enabled'), updated_at = NOW()
+WHERE id = pggen.arg('endpoint_id');
+
+-- name: DeleteGatewayEndpoint :exec
+DELETE FROM gateway_endpoints
+WHERE id = pggen.arg('endpoint_id');
+
+-- name: IncrementRelayUsage :exec
+INSERT INTO relay_usage (caller_id, chain_id, hour, successful_relays, failed_relays, altruist_relays, request_bytes, response_bytes)
+SELECT *
+FROM unnest(pggen.arg('caller_ids')::TEXT[], pggen.arg('chain_ids')::TEXT[], pggen.arg('hours')::TIMESTAMP[],
+            pggen.arg('successful_relays')::BIGINT[], pggen.arg('failed_relays')::BIGINT[], pggen.arg('altruist_relays')::BIGINT[],
+            pggen.arg('request_bytes')::BIGINT[], pggen.arg('response_bytes')::BIGINT[])
+ON CONFLICT (caller_id, chain_id, hour)
+DO UPDATE SET successful_relays = relay_usage.successful_relays + EXCLUDED.successful_relays,
+              failed_relays = relay_usage.failed_relays + EXCLUDED.failed_relays,
+              altruist_relays = relay_usage.altruist_relays + EXCLUDED.altruist_relays,
+              request_bytes = relay_usage.request_bytes + EXCLUDED.request_bytes,
+              response_bytes = relay_usage.response_bytes + EXCLUDED.response_bytes;
+
+-- name: GetRelayUsage :many
+SELECT caller_id, chain_id, hour, successful_relays, failed_relays, altruist_relays, request_bytes, response_bytes
+FROM relay_usage
+WHERE hour >= pggen.arg('start_hour') AND hour < pggen.arg('end_hour')
+  AND (pggen.arg('caller_id')::VARCHAR = '' OR caller_id = pggen.arg('caller_id'))
+ORDER BY hour, caller_id, chain_id;
+
+-- name: GetAdminAPIKeys :many
+SELECT id, name, api_key_hash, scopes
+FROM admin_api_keys
+WHERE deleted_at IS NULL;
+
+-- name: InsertAdminAPIKey :one
+INSERT INTO admin_api_keys (name, api_key_hash, scopes)
+VALUES (pggen.arg('name'), pggen.arg('api_key_hash'), pggen.arg('scopes'))
+RETURNING id;
+
+-- name: DeleteAdminAPIKey :exec
+DELETE FROM admin_api_keys
+WHERE id = pggen.arg('admin_api_key_id');
+
+-- name: InsertAdminAuditLog :exec
+INSERT INTO admin_audit_logs (admin_api_key_id, admin_api_key_name, scope, method, path, status_code)
+VALUES (pggen.arg('admin_api_key_id'), pggen.arg('admin_api_key_name'), pggen.arg('scope'), pggen.arg('method'), pggen.arg('path'), pggen.arg('status_code'));
+
+-- name: GetAdminAuditLogs :many
+SELECT admin_api_key_id, admin_api_key_name, scope, method, path, status_code, created_at
+FROM admin_audit_logs
+ORDER BY created_at DESC
+LIMIT pggen.arg('max_rows');
@@ -19,6 +19,50 @@ type Querier interface {
 	DeletePoktApplication(ctx context.Context, applicationID pgtype.UUID) (pgconn.CommandTag, error)
 
 	GetChainConfigurations(ctx context.Context) ([]GetChainConfigurationsRow, error)
+
+	InsertChainConfiguration(ctx context.Context, params InsertChainConfigurationParams) (pgtype.UUID, error)
+
+	UpdateChainConfiguration(ctx context.Context, params UpdateChainConfigurationParams) (pgconn.CommandTag, error)
+
+	DeleteChainConfiguration(ctx context.Context, chainID string) (pgconn.CommandTag, error)
+
+	GetChainAltruists(ctx context.Context) ([]GetChainAltruistsRow, error)
+
+	GetChainChecks(ctx context.Context) ([]GetChainChecksRow, error)
+
+	GetRelayRateLimits(ctx context.Context) ([]GetRelayRateLimitsRow, error)
+
+	GetRelayQuotaUsage(ctx context.Context, dayStart pgtype.Timestamp, monthStart pgtype.Timestamp) ([]GetRelayQuotaUsageRow, error)
+
+	IncrementRelayQuotaUsage(ctx context.Context, params IncrementRelayQuotaUsageParams) (pgconn.CommandTag, error)
+
+	GetGatewayUsers(ctx context.Context) ([]GetGatewayUsersRow, error)
+
+	InsertGatewayUser(ctx context.Context, email string, name string) (pgtype.UUID, error)
+
+	DeleteGatewayUser(ctx context.Context, userID pgtype.UUID) (pgconn.CommandTag, error)
+
+	GetGatewayEndpoints(ctx context.Context) ([]GetGatewayEndpointsRow, error)
+
+	InsertGatewayEndpoint(ctx context.Context, params InsertGatewayEndpointParams) (pgtype.UUID, error)
+
+	UpdateGatewayEndpoint(ctx context.Context, params UpdateGatewayEndpointParams) (pgconn.CommandTag, error)
+
+	DeleteGatewayEndpoint(ctx context.Context, endpointID pgtype.UUID) (pgconn.CommandTag, error)
+
+	IncrementRelayUsage(ctx context.Context, params IncrementRelayUsageParams) (pgconn.CommandTag, error)
+
+	GetRelayUsage(ctx context.Context, params GetRelayUsageParams) ([]GetRelayUsageRow, error)
+
+	GetAdminAPIKeys(ctx context.Context) ([]GetAdminAPIKeysRow, error)
+
+	InsertAdminAPIKey(ctx context.Context, params InsertAdminAPIKeyParams) (pgtype.UUID, error)
+
+	DeleteAdminAPIKey(ctx context.Context, adminAPIKeyID pgtype.UUID) (pgconn.CommandTag, error)
+
+	InsertAdminAuditLog(ctx context.Context, params InsertAdminAuditLogParams) (pgconn.CommandTag, error)
+
+	GetAdminAuditLogs(ctx context.Context, maxRows int) ([]GetAdminAuditLogsRow, error)
 }
 
 var _ Querier = &DBQuerier{}
@@ -139,6 +183,17 @@ type GetChainConfigurationsRow struct {
 	TopBucketP90latencyDuration      pgtype.Varchar   `json:"top_bucket_p90latency_duration"`
 	HeightCheckBlockTolerance        *int32           `json:"height_check_block_tolerance"`
 	DataIntegrityCheckLookbackHeight *int32           `json:"data_integrity_check_lookback_height"`
+	NodeSelectionStrategy            pgtype.Varchar   `json:"node_selection_strategy"`
+	RelayMaxAttempts                 *int32           `json:"relay_max_attempts"`
+	RelayRetryDeadlineDuration       pgtype.Varchar   `json:"relay_retry_deadline_duration"`
+	RelayHedgeDelayDuration          pgtype.Varchar   `json:"relay_hedge_delay_duration"`
+	BatchRelayMode                   pgtype.Varchar   `json:"batch_relay_mode"`
+	AltruistWebsocketUrl             pgtype.Varchar   `json:"altruist_websocket_url"`
+	RelayCacheTtls                   pgtype.Varchar   `json:"relay_cache_ttls"`
+	RelayValidationSampleRate        *float32         `json:"relay_validation_sample_rate"`
+	EvmChainID                       pgtype.Varchar   `json:"evm_chain_id"`
+	ArchivalCheckBlockHeight         *int32           `json:"archival_check_block_height"`
+	ChainFamily                      pgtype.Varchar   `json:"chain_family"`
 }
 
 // GetChainConfigurations implements Querier.GetChainConfigurations.
@@ -152,7 +207,7 @@ func (q *DBQuerier) GetChainConfigurations(ctx context.Context) ([]GetChainConfi
 	items := []GetChainConfigurationsRow{}
 	for rows.Next() {
 		var item GetChainConfigurationsRow
-		if err := rows.Scan(&item.CreatedAt, &item.UpdatedAt, &item.DeletedAt, &item.ID, &item.ChainID, &item.PocketRequestTimeoutDuration, &item.AltruistUrl, &item.AltruistRequestTimeoutDuration, &item.TopBucketP90latencyDuration, &item.HeightCheckBlockTolerance, &item.DataIntegrityCheckLookbackHeight); err != nil {
+		if err := rows.Scan(&item.CreatedAt, &item.UpdatedAt, &item.DeletedAt, &item.ID, &item.ChainID, &item.PocketRequestTimeoutDuration, &item.AltruistUrl, &item.AltruistRequestTimeoutDuration, &item.TopBucketP90latencyDuration, &item.HeightCheckBlockTolerance, &item.DataIntegrityCheckLookbackHeight, &item.NodeSelectionStrategy, &item.RelayMaxAttempts, &item.RelayRetryDeadlineDuration, &item.RelayHedgeDelayDuration, &item.BatchRelayMode, &item.AltruistWebsocketUrl, &item.RelayCacheTtls, &item.RelayValidationSampleRate, &item.EvmChainID, &item.ArchivalCheckBlockHeight, &item.ChainFamily); err != nil {
 			return nil, fmt.Errorf("scan GetChainConfigurations row: %w", err)
 		}
 		items = append(items, item)
@@ -163,6 +218,611 @@ func (q *DBQuerier) GetChainConfigurations(ctx context.Context) ([]GetChainConfi
 	return items, err
 }
 
+const insertChainConfigurationSQL = `INSERT INTO chain_configurations (chain_id, pocket_request_timeout_duration, altruist_url, altruist_request_timeout_duration, top_bucket_p90latency_duration, height_check_block_tolerance, data_integrity_check_lookback_height, node_selection_strategy, relay_max_attempts, relay_retry_deadline_duration, relay_hedge_delay_duration, batch_relay_mode, altruist_websocket_url, relay_cache_ttls, relay_validation_sample_rate, evm_chain_id, archival_check_block_height, chain_family)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), NULLIF($9, 0), NULLIF($10, ''), NULLIF($11, ''), NULLIF($12, ''), NULLIF($13, ''), NULLIF($14, ''), NULLIF($15, 0), NULLIF($16, ''), NULLIF($17, 0), NULLIF($18, ''))
+RETURNING id;`
+
+type InsertChainConfigurationParams struct {
+	ChainID                          string
+	PocketRequestTimeoutDuration     string
+	AltruistUrl                      string
+	AltruistRequestTimeoutDuration   string
+	TopBucketP90latencyDuration      string
+	HeightCheckBlockTolerance        int32
+	DataIntegrityCheckLookbackHeight int32
+	NodeSelectionStrategy            string
+	RelayMaxAttempts                 int32
+	RelayRetryDeadlineDuration       string
+	RelayHedgeDelayDuration          string
+	BatchRelayMode                   string
+	AltruistWebsocketUrl             string
+	RelayCacheTtls                   string
+	RelayValidationSampleRate        float32
+	EvmChainID                       string
+	ArchivalCheckBlockHeight         int32
+	ChainFamily                      string
+}
+
+// InsertChainConfiguration implements Querier.InsertChainConfiguration.
+func (q *DBQuerier) InsertChainConfiguration(ctx context.Context, params InsertChainConfigurationParams) (pgtype.UUID, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "InsertChainConfiguration")
+	row := q.conn.QueryRow(ctx, insertChainConfigurationSQL, params.ChainID, params.PocketRequestTimeoutDuration, params.AltruistUrl, params.AltruistRequestTimeoutDuration, params.TopBucketP90latencyDuration, params.HeightCheckBlockTolerance, params.DataIntegrityCheckLookbackHeight, params.NodeSelectionStrategy, params.RelayMaxAttempts, params.RelayRetryDeadlineDuration, params.RelayHedgeDelayDuration, params.BatchRelayMode, params.AltruistWebsocketUrl, params.RelayCacheTtls, params.RelayValidationSampleRate, params.EvmChainID, params.ArchivalCheckBlockHeight, params.ChainFamily)
+	var item pgtype.UUID
+	if err := row.Scan(&item); err != nil {
+		return item, fmt.Errorf("query InsertChainConfiguration: %w", err)
+	}
+	return item, nil
+}
+
+const updateChainConfigurationSQL = `UPDATE chain_configurations
+SET pocket_request_timeout_duration = $1, altruist_url = $2, altruist_request_timeout_duration = $3, top_bucket_p90latency_duration = $4, height_check_block_tolerance = $5, data_integrity_check_lookback_height = $6, node_selection_strategy = NULLIF($7, ''), relay_max_attempts = NULLIF($8, 0), relay_retry_deadline_duration = NULLIF($9, ''), relay_hedge_delay_duration = NULLIF($10, ''), batch_relay_mode = NULLIF($11, ''), altruist_websocket_url = NULLIF($12, ''), relay_cache_ttls = NULLIF($13, ''), relay_validation_sample_rate = NULLIF($14, 0), evm_chain_id = NULLIF($15, ''), archival_check_block_height = NULLIF($16, 0), chain_family = NULLIF($17, ''), updated_at = NOW()
+WHERE chain_id = $18;`
+
+type UpdateChainConfigurationParams struct {
+	PocketRequestTimeoutDuration     string
+	AltruistUrl                      string
+	AltruistRequestTimeoutDuration   string
+	TopBucketP90latencyDuration      string
+	HeightCheckBlockTolerance        int32
+	DataIntegrityCheckLookbackHeight int32
+	NodeSelectionStrategy            string
+	RelayMaxAttempts                 int32
+	RelayRetryDeadlineDuration       string
+	RelayHedgeDelayDuration          string
+	BatchRelayMode                   string
+	AltruistWebsocketUrl             string
+	RelayCacheTtls                   string
+	RelayValidationSampleRate        float32
+	EvmChainID                       string
+	ArchivalCheckBlockHeight         int32
+	ChainFamily                      string
+	ChainID                          string
+}
+
+// UpdateChainConfiguration implements Querier.UpdateChainConfiguration.
+func (q *DBQuerier) UpdateChainConfiguration(ctx context.Context, params UpdateChainConfigurationParams) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateChainConfiguration")
+	cmdTag, err := q.conn.Exec(ctx, updateChainConfigurationSQL, params.PocketRequestTimeoutDuration, params.AltruistUrl, params.AltruistRequestTimeoutDuration, params.TopBucketP90latencyDuration, params.HeightCheckBlockTolerance, params.DataIntegrityCheckLookbackHeight, params.NodeSelectionStrategy, params.RelayMaxAttempts, params.RelayRetryDeadlineDuration, params.RelayHedgeDelayDuration, params.BatchRelayMode, params.AltruistWebsocketUrl, params.RelayCacheTtls, params.RelayValidationSampleRate, params.EvmChainID, params.ArchivalCheckBlockHeight, params.ChainFamily, params.ChainID)
+	if err != nil {
+		return cmdTag, fmt.Errorf("exec query UpdateChainConfiguration: %w", err)
+	}
+	return cmdTag, err
+}
+
+const deleteChainConfigurationSQL = `DELETE FROM chain_configurations
+WHERE chain_id = $1;`
+
+// DeleteChainConfiguration implements Querier.DeleteChainConfiguration.
+func (q *DBQuerier) DeleteChainConfiguration(ctx context.Context, chainID string) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "DeleteChainConfiguration")
+	cmdTag, err := q.conn.Exec(ctx, deleteChainConfigurationSQL, chainID)
+	if err != nil {
+		return cmdTag, fmt.Errorf("exec query DeleteChainConfiguration: %w", err)
+	}
+	return cmdTag, err
+}
+
+const getChainAltruistsSQL = `SELECT id, chain_id, url, weight, auth_headers, request_timeout_duration
+FROM chain_altruists
+WHERE deleted_at IS NULL;`
+
+type GetChainAltruistsRow struct {
+	ID                     pgtype.UUID    `json:"id"`
+	ChainID                pgtype.Varchar `json:"chain_id"`
+	Url                    pgtype.Varchar `json:"url"`
+	Weight                 *int32         `json:"weight"`
+	AuthHeaders            pgtype.Varchar `json:"auth_headers"`
+	RequestTimeoutDuration pgtype.Varchar `json:"request_timeout_duration"`
+}
+
+// GetChainAltruists implements Querier.GetChainAltruists.
+func (q *DBQuerier) GetChainAltruists(ctx context.Context) ([]GetChainAltruistsRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "GetChainAltruists")
+	rows, err := q.conn.Query(ctx, getChainAltruistsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("query GetChainAltruists: %w", err)
+	}
+	defer rows.Close()
+	items := []GetChainAltruistsRow{}
+	for rows.Next() {
+		var item GetChainAltruistsRow
+		if err := rows.Scan(&item.ID, &item.ChainID, &item.Url, &item.Weight, &item.AuthHeaders, &item.RequestTimeoutDuration); err != nil {
+			return nil, fmt.Errorf("scan GetChainAltruists row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("close GetChainAltruists rows: %w", err)
+	}
+	return items, err
+}
+
+const getChainChecksSQL = `SELECT id, chain_id, name, method, path, payload, json_path, comparison, expected_value, height_tolerance, interval_duration, penalty_duration
+FROM chain_checks
+WHERE deleted_at IS NULL;`
+
+type GetChainChecksRow struct {
+	ID               pgtype.UUID    `json:"id"`
+	ChainID          pgtype.Varchar `json:"chain_id"`
+	Name             pgtype.Varchar `json:"name"`
+	Method           pgtype.Varchar `json:"method"`
+	Path             pgtype.Varchar `json:"path"`
+	Payload          pgtype.Varchar `json:"payload"`
+	JsonPath         pgtype.Varchar `json:"json_path"`
+	Comparison       pgtype.Varchar `json:"comparison"`
+	ExpectedValue    pgtype.Varchar `json:"expected_value"`
+	HeightTolerance  *int32         `json:"height_tolerance"`
+	IntervalDuration pgtype.Varchar `json:"interval_duration"`
+	PenaltyDuration  pgtype.Varchar `json:"penalty_duration"`
+}
+
+// GetChainChecks implements Querier.GetChainChecks.
+func (q *DBQuerier) GetChainChecks(ctx context.Context) ([]GetChainChecksRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "GetChainChecks")
+	rows, err := q.conn.Query(ctx, getChainChecksSQL)
+	if err != nil {
+		return nil, fmt.Errorf("query GetChainChecks: %w", err)
+	}
+	defer rows.Close()
+	items := []GetChainChecksRow{}
+	for rows.Next() {
+		var item GetChainChecksRow
+		if err := rows.Scan(&item.ID, &item.ChainID, &item.Name, &item.Method, &item.Path, &item.Payload, &item.JsonPath, &item.Comparison, &item.ExpectedValue, &item.HeightTolerance, &item.IntervalDuration, &item.PenaltyDuration); err != nil {
+			return nil, fmt.Errorf("scan GetChainChecks row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("close GetChainChecks rows: %w", err)
+	}
+	return items, err
+}
+
+const getRelayRateLimitsSQL = `SELECT api_key_hash, requests_per_second, burst, daily_relay_quota, monthly_relay_quota
+FROM relay_rate_limits
+WHERE deleted_at IS NULL;`
+
+type GetRelayRateLimitsRow struct {
+	ApiKeyHash        pgtype.Varchar `json:"api_key_hash"`
+	RequestsPerSecond *int32         `json:"requests_per_second"`
+	Burst             *int32         `json:"burst"`
+	DailyRelayQuota   *int           `json:"daily_relay_quota"`
+	MonthlyRelayQuota *int           `json:"monthly_relay_quota"`
+}
+
+// GetRelayRateLimits implements Querier.GetRelayRateLimits.
+func (q *DBQuerier) GetRelayRateLimits(ctx context.Context) ([]GetRelayRateLimitsRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "GetRelayRateLimits")
+	rows, err := q.conn.Query(ctx, getRelayRateLimitsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("query GetRelayRateLimits: %w", err)
+	}
+	defer rows.Close()
+	items := []GetRelayRateLimitsRow{}
+	for rows.Next() {
+		var item GetRelayRateLimitsRow
+		if err := rows.Scan(&item.ApiKeyHash, &item.RequestsPerSecond, &item.Burst, &item.DailyRelayQuota, &item.MonthlyRelayQuota); err != nil {
+			return nil, fmt.Errorf("scan GetRelayRateLimits row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("close GetRelayRateLimits rows: %w", err)
+	}
+	return items, err
+}
+
+const getRelayQuotaUsageSQL = `SELECT api_key_hash, period, relay_count
+FROM relay_quota_usage
+WHERE (period = 'day' AND period_start = $1)
+   OR (period = 'month' AND period_start = $2);`
+
+type GetRelayQuotaUsageRow struct {
+	ApiKeyHash pgtype.Varchar `json:"api_key_hash"`
+	Period     pgtype.Varchar `json:"period"`
+	RelayCount *int           `json:"relay_count"`
+}
+
+// GetRelayQuotaUsage implements Querier.GetRelayQuotaUsage.
+func (q *DBQuerier) GetRelayQuotaUsage(ctx context.Context, dayStart pgtype.Timestamp, monthStart pgtype.Timestamp) ([]GetRelayQuotaUsageRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "GetRelayQuotaUsage")
+	rows, err := q.conn.Query(ctx, getRelayQuotaUsageSQL, dayStart, monthStart)
+	if err != nil {
+		return nil, fmt.Errorf("query GetRelayQuotaUsage: %w", err)
+	}
+	defer rows.Close()
+	items := []GetRelayQuotaUsageRow{}
+	for rows.Next() {
+		var item GetRelayQuotaUsageRow
+		if err := rows.Scan(&item.ApiKeyHash, &item.Period, &item.RelayCount); err != nil {
+			return nil, fmt.Errorf("scan GetRelayQuotaUsage row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("close GetRelayQuotaUsage rows: %w", err)
+	}
+	return items, err
+}
+
+const incrementRelayQuotaUsageSQL = `INSERT INTO relay_quota_usage (api_key_hash, period, period_start, relay_count)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (api_key_hash, period, period_start)
+DO UPDATE SET relay_count = relay_quota_usage.relay_count + EXCLUDED.relay_count;`
+
+type IncrementRelayQuotaUsageParams struct {
+	ApiKeyHash  string
+	Period      string
+	PeriodStart pgtype.Timestamp
+	RelayCount  int
+}
+
+// IncrementRelayQuotaUsage implements Querier.IncrementRelayQuotaUsage.
+func (q *DBQuerier) IncrementRelayQuotaUsage(ctx context.Context, params IncrementRelayQuotaUsageParams) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "IncrementRelayQuotaUsage")
+	cmdTag, err := q.conn.Exec(ctx, incrementRelayQuotaUsageSQL, params.ApiKeyHash, params.Period, params.PeriodStart, params.RelayCount)
+	if err != nil {
+		return cmdTag, fmt.Errorf("exec query IncrementRelayQuotaUsage: %w", err)
+	}
+	return cmdTag, err
+}
+
+const getGatewayUsersSQL = `SELECT id, email, name
+FROM gateway_users
+WHERE deleted_at IS NULL;`
+
+type GetGatewayUsersRow struct {
+	ID    pgtype.UUID    `json:"id"`
+	Email pgtype.Varchar `json:"email"`
+	Name  pgtype.Varchar `json:"name"`
+}
+
+// GetGatewayUsers implements Querier.GetGatewayUsers.
+func (q *DBQuerier) GetGatewayUsers(ctx context.Context) ([]GetGatewayUsersRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "GetGatewayUsers")
+	rows, err := q.conn.Query(ctx, getGatewayUsersSQL)
+	if err != nil {
+		return nil, fmt.Errorf("query GetGatewayUsers: %w", err)
+	}
+	defer rows.Close()
+	items := []GetGatewayUsersRow{}
+	for rows.Next() {
+		var item GetGatewayUsersRow
+		if err := rows.Scan(&item.ID, &item.Email, &item.Name); err != nil {
+			return nil, fmt.Errorf("scan GetGatewayUsers row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("close GetGatewayUsers rows: %w", err)
+	}
+	return items, err
+}
+
+const insertGatewayUserSQL = `INSERT INTO gateway_users (email, name)
+VALUES ($1, $2)
+RETURNING id;`
+
+// InsertGatewayUser implements Querier.InsertGatewayUser.
+func (q *DBQuerier) InsertGatewayUser(ctx context.Context, email string, name string) (pgtype.UUID, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "InsertGatewayUser")
+	row := q.conn.QueryRow(ctx, insertGatewayUserSQL, email, name)
+	var item pgtype.UUID
+	if err := row.Scan(&item); err != nil {
+		return item, fmt.Errorf("query InsertGatewayUser: %w", err)
+	}
+	return item, nil
+}
+
+const deleteGatewayUserSQL = `DELETE FROM gateway_users
+WHERE id = $1;`
+
+// DeleteGatewayUser implements Querier.DeleteGatewayUser.
+func (q *DBQuerier) DeleteGatewayUser(ctx context.Context, userID pgtype.UUID) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "DeleteGatewayUser")
+	cmdTag, err := q.conn.Exec(ctx, deleteGatewayUserSQL, userID)
+	if err != nil {
+		return cmdTag, fmt.Errorf("exec query DeleteGatewayUser: %w", err)
+	}
+	return cmdTag, err
+}
+
+const getGatewayEndpointsSQL = `SELECT id, user_id, name, api_key_hash, allowed_chains, enabled
+FROM gateway_endpoints
+WHERE deleted_at IS NULL;`
+
+type GetGatewayEndpointsRow struct {
+	ID            pgtype.UUID    `json:"id"`
+	UserID        pgtype.UUID    `json:"user_id"`
+	Name          pgtype.Varchar `json:"name"`
+	ApiKeyHash    pgtype.Varchar `json:"api_key_hash"`
+	AllowedChains []string       `json:"allowed_chains"`
+	Enabled       *bool          `json:"enabled"`
+}
+
+// GetGatewayEndpoints implements Querier.GetGatewayEndpoints.
+func (q *DBQuerier) GetGatewayEndpoints(ctx context.Context) ([]GetGatewayEndpointsRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "GetGatewayEndpoints")
+	rows, err := q.conn.Query(ctx, getGatewayEndpointsSQL)
+	if err != nil {
+		return nil, fmt.Errorf("query GetGatewayEndpoints: %w", err)
+	}
+	defer rows.Close()
+	items := []GetGatewayEndpointsRow{}
+	for rows.Next() {
+		var item GetGatewayEndpointsRow
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Name, &item.ApiKeyHash, &item.AllowedChains, &item.Enabled); err != nil {
+			return nil, fmt.Errorf("scan GetGatewayEndpoints row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("close GetGatewayEndpoints rows: %w", err)
+	}
+	return items, err
+}
+
+const insertGatewayEndpointSQL = `INSERT INTO gateway_endpoints (user_id, name, api_key_hash, allowed_chains, enabled)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id;`
+
+type InsertGatewayEndpointParams struct {
+	UserID        pgtype.UUID
+	Name          string
+	ApiKeyHash    string
+	AllowedChains []string
+	Enabled       bool
+}
+
+// InsertGatewayEndpoint implements Querier.InsertGatewayEndpoint.
+func (q *DBQuerier) InsertGatewayEndpoint(ctx context.Context, params InsertGatewayEndpointParams) (pgtype.UUID, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "InsertGatewayEndpoint")
+	row := q.conn.QueryRow(ctx, insertGatewayEndpointSQL, params.UserID, params.Name, params.ApiKeyHash, params.AllowedChains, params.Enabled)
+	var item pgtype.UUID
+	if err := row.Scan(&item); err != nil {
+		return item, fmt.Errorf("query InsertGatewayEndpoint: %w", err)
+	}
+	return item, nil
+}
+
+const updateGatewayEndpointSQL = `UPDATE gateway_endpoints
+SET name = $1, allowed_chains = $2, enabled = $3, updated_at = NOW()
+WHERE id = $4;`
+
+type UpdateGatewayEndpointParams struct {
+	Name          string
+	AllowedChains []string
+	Enabled       bool
+	EndpointID    pgtype.UUID
+}
+
+// UpdateGatewayEndpoint implements Querier.UpdateGatewayEndpoint.
+func (q *DBQuerier) UpdateGatewayEndpoint(ctx context.Context, params UpdateGatewayEndpointParams) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "UpdateGatewayEndpoint")
+	cmdTag, err := q.conn.Exec(ctx, updateGatewayEndpointSQL, params.Name, params.AllowedChains, params.Enabled, params.EndpointID)
+	if err != nil {
+		return cmdTag, fmt.Errorf("exec query UpdateGatewayEndpoint: %w", err)
+	}
+	return cmdTag, err
+}
+
+const deleteGatewayEndpointSQL = `DELETE FROM gateway_endpoints
+WHERE id = $1;`
+
+// DeleteGatewayEndpoint implements Querier.DeleteGatewayEndpoint.
+func (q *DBQuerier) DeleteGatewayEndpoint(ctx context.Context, endpointID pgtype.UUID) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "DeleteGatewayEndpoint")
+	cmdTag, err := q.conn.Exec(ctx, deleteGatewayEndpointSQL, endpointID)
+	if err != nil {
+		return cmdTag, fmt.Errorf("exec query DeleteGatewayEndpoint: %w", err)
+	}
+	return cmdTag, err
+}
+
+const incrementRelayUsageSQL = `INSERT INTO relay_usage (caller_id, chain_id, hour, successful_relays, failed_relays, altruist_relays, request_bytes, response_bytes)
+SELECT *
+FROM unnest($1::TEXT[], $2::TEXT[], $3::TIMESTAMP[],
+            $4::BIGINT[], $5::BIGINT[], $6::BIGINT[],
+            $7::BIGINT[], $8::BIGINT[])
+ON CONFLICT (caller_id, chain_id, hour)
+DO UPDATE SET successful_relays = relay_usage.successful_relays + EXCLUDED.successful_relays,
+              failed_relays = relay_usage.failed_relays + EXCLUDED.failed_relays,
+              altruist_relays = relay_usage.altruist_relays + EXCLUDED.altruist_relays,
+              request_bytes = relay_usage.request_bytes + EXCLUDED.request_bytes,
+              response_bytes = relay_usage.response_bytes + EXCLUDED.response_bytes;`
+
+type IncrementRelayUsageParams struct {
+	CallerIds        []string
+	ChainIds         []string
+	Hours            pgtype.TimestampArray
+	SuccessfulRelays []int
+	FailedRelays     []int
+	AltruistRelays   []int
+	RequestBytes     []int
+	ResponseBytes    []int
+}
+
+// IncrementRelayUsage implements Querier.IncrementRelayUsage.
+func (q *DBQuerier) IncrementRelayUsage(ctx context.Context, params IncrementRelayUsageParams) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "IncrementRelayUsage")
+	cmdTag, err := q.conn.Exec(ctx, incrementRelayUsageSQL, params.CallerIds, params.ChainIds, params.Hours, params.SuccessfulRelays, params.FailedRelays, params.AltruistRelays, params.RequestBytes, params.ResponseBytes)
+	if err != nil {
+		return cmdTag, fmt.Errorf("exec query IncrementRelayUsage: %w", err)
+	}
+	return cmdTag, err
+}
+
+const getRelayUsageSQL = `SELECT caller_id, chain_id, hour, successful_relays, failed_relays, altruist_relays, request_bytes, response_bytes
+FROM relay_usage
+WHERE hour >= $1 AND hour < $2
+  AND ($3::VARCHAR = '' OR caller_id = $3)
+ORDER BY hour, caller_id, chain_id;`
+
+type GetRelayUsageParams struct {
+	StartHour pgtype.Timestamp
+	EndHour   pgtype.Timestamp
+	CallerID  string
+}
+
+type GetRelayUsageRow struct {
+	CallerID         pgtype.Varchar   `json:"caller_id"`
+	ChainID          pgtype.Varchar   `json:"chain_id"`
+	Hour             pgtype.Timestamp `json:"hour"`
+	SuccessfulRelays *int             `json:"successful_relays"`
+	FailedRelays     *int             `json:"failed_relays"`
+	AltruistRelays   *int             `json:"altruist_relays"`
+	RequestBytes     *int             `json:"request_bytes"`
+	ResponseBytes    *int             `json:"response_bytes"`
+}
+
+// GetRelayUsage implements Querier.GetRelayUsage.
+func (q *DBQuerier) GetRelayUsage(ctx context.Context, params GetRelayUsageParams) ([]GetRelayUsageRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "GetRelayUsage")
+	rows, err := q.conn.Query(ctx, getRelayUsageSQL, params.StartHour, params.EndHour, params.CallerID)
+	if err != nil {
+		return nil, fmt.Errorf("query GetRelayUsage: %w", err)
+	}
+	defer rows.Close()
+	items := []GetRelayUsageRow{}
+	for rows.Next() {
+		var item GetRelayUsageRow
+		if err := rows.Scan(&item.CallerID, &item.ChainID, &item.Hour, &item.SuccessfulRelays, &item.FailedRelays, &item.AltruistRelays, &item.RequestBytes, &item.ResponseBytes); err != nil {
+			return nil, fmt.Errorf("scan GetRelayUsage row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("close GetRelayUsage rows: %w", err)
+	}
+	return items, err
+}
+
+const getAdminAPIKeysSQL = `SELECT id, name, api_key_hash, scopes
+FROM admin_api_keys
+WHERE deleted_at IS NULL;`
+
+type GetAdminAPIKeysRow struct {
+	ID         pgtype.UUID    `json:"id"`
+	Name       pgtype.Varchar `json:"name"`
+	ApiKeyHash pgtype.Varchar `json:"api_key_hash"`
+	Scopes     []string       `json:"scopes"`
+}
+
+// GetAdminAPIKeys implements Querier.GetAdminAPIKeys.
+func (q *DBQuerier) GetAdminAPIKeys(ctx context.Context) ([]GetAdminAPIKeysRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "GetAdminAPIKeys")
+	rows, err := q.conn.Query(ctx, getAdminAPIKeysSQL)
+	if err != nil {
+		return nil, fmt.Errorf("query GetAdminAPIKeys: %w", err)
+	}
+	defer rows.Close()
+	items := []GetAdminAPIKeysRow{}
+	for rows.Next() {
+		var item GetAdminAPIKeysRow
+		if err := rows.Scan(&item.ID, &item.Name, &item.ApiKeyHash, &item.Scopes); err != nil {
+			return nil, fmt.Errorf("scan GetAdminAPIKeys row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("close GetAdminAPIKeys rows: %w", err)
+	}
+	return items, err
+}
+
+const insertAdminAPIKeySQL = `INSERT INTO admin_api_keys (name, api_key_hash, scopes)
+VALUES ($1, $2, $3)
+RETURNING id;`
+
+type InsertAdminAPIKeyParams struct {
+	Name       string
+	ApiKeyHash string
+	Scopes     []string
+}
+
+// InsertAdminAPIKey implements Querier.InsertAdminAPIKey.
+func (q *DBQuerier) InsertAdminAPIKey(ctx context.Context, params InsertAdminAPIKeyParams) (pgtype.UUID, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "InsertAdminAPIKey")
+	row := q.conn.QueryRow(ctx, insertAdminAPIKeySQL, params.Name, params.ApiKeyHash, params.Scopes)
+	var item pgtype.UUID
+	if err := row.Scan(&item); err != nil {
+		return item, fmt.Errorf("query InsertAdminAPIKey: %w", err)
+	}
+	return item, nil
+}
+
+const deleteAdminAPIKeySQL = `DELETE FROM admin_api_keys
+WHERE id = $1;`
+
+// DeleteAdminAPIKey implements Querier.DeleteAdminAPIKey.
+func (q *DBQuerier) DeleteAdminAPIKey(ctx context.Context, adminAPIKeyID pgtype.UUID) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "DeleteAdminAPIKey")
+	cmdTag, err := q.conn.Exec(ctx, deleteAdminAPIKeySQL, adminAPIKeyID)
+	if err != nil {
+		return cmdTag, fmt.Errorf("exec query DeleteAdminAPIKey: %w", err)
+	}
+	return cmdTag, err
+}
+
+const insertAdminAuditLogSQL = `INSERT INTO admin_audit_logs (admin_api_key_id, admin_api_key_name, scope, method, path, status_code)
+VALUES ($1, $2, $3, $4, $5, $6);`
+
+type InsertAdminAuditLogParams struct {
+	AdminAPIKeyID   pgtype.UUID
+	AdminAPIKeyName string
+	Scope           string
+	Method          string
+	Path            string
+	StatusCode      int32
+}
+
+// InsertAdminAuditLog implements Querier.InsertAdminAuditLog.
+func (q *DBQuerier) InsertAdminAuditLog(ctx context.Context, params InsertAdminAuditLogParams) (pgconn.CommandTag, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "InsertAdminAuditLog")
+	cmdTag, err := q.conn.Exec(ctx, insertAdminAuditLogSQL, params.AdminAPIKeyID, params.AdminAPIKeyName, params.Scope, params.Method, params.Path, params.StatusCode)
+	if err != nil {
+		return cmdTag, fmt.Errorf("exec query InsertAdminAuditLog: %w", err)
+	}
+	return cmdTag, err
+}
+
+const getAdminAuditLogsSQL = `SELECT admin_api_key_id, admin_api_key_name, scope, method, path, status_code, created_at
+FROM admin_audit_logs
+ORDER BY created_at DESC
+LIMIT $1;`
+
+type GetAdminAuditLogsRow struct {
+	AdminAPIKeyID   pgtype.UUID      `json:"admin_api_key_id"`
+	AdminAPIKeyName pgtype.Varchar   `json:"admin_api_key_name"`
+	Scope           pgtype.Varchar   `json:"scope"`
+	Method          pgtype.Varchar   `json:"method"`
+	Path            pgtype.Varchar   `json:"path"`
+	StatusCode      *int32           `json:"status_code"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+}
+
+// GetAdminAuditLogs implements Querier.GetAdminAuditLogs.
+func (q *DBQuerier) GetAdminAuditLogs(ctx context.Context, maxRows int) ([]GetAdminAuditLogsRow, error) {
+	ctx = context.WithValue(ctx, "pggen_query_name", "GetAdminAuditLogs")
+	rows, err := q.conn.Query(ctx, getAdminAuditLogsSQL, maxRows)
+	if err != nil {
+		return nil, fmt.Errorf("query GetAdminAuditLogs: %w", err)
+	}
+	defer rows.Close()
+	items := []GetAdminAuditLogsRow{}
+	for rows.Next() {
+		var item GetAdminAuditLogsRow
+		if err := rows.Scan(&item.AdminAPIKeyID, &item.AdminAPIKeyName, &item.Scope, &item.Method, &item.Path, &item.StatusCode, &item.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan GetAdminAuditLogs row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("close GetAdminAuditLogs rows: %w", err)
+	}
+	return items, err
+}
+
 // textPreferrer wraps a pgtype.ValueTranscoder and sets the preferred encoding
 // format to text instead binary (the default). pggen uses the text format
 // when the OID is unknownOID because the binary format requires the OID.
@@ -0,0 +1,84 @@
+package gateway_endpoints_registry
+
+import (
+	"context"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/pkg/common"
+	"go.uber.org/zap"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	gatewayEndpointsUpdateInterval = time.Minute * 1
+)
+
+type CachedGatewayEndpointsRegistry struct {
+	dbQuery               db_query.Querier
+	gatewayEndpointsCache map[string]db_query.GetGatewayEndpointsRow // api key hash > endpoint
+	cacheLock             sync.RWMutex
+	logger                *zap.Logger
+}
+
+func NewCachedGatewayEndpointsRegistry(dbQuery db_query.Querier, logger *zap.Logger) *CachedGatewayEndpointsRegistry {
+	gatewayEndpointsRegistry := &CachedGatewayEndpointsRegistry{dbQuery: dbQuery, gatewayEndpointsCache: map[string]db_query.GetGatewayEndpointsRow{}, logger: logger}
+	err := gatewayEndpointsRegistry.Refresh()
+	if err != nil {
+		gatewayEndpointsRegistry.logger.Sugar().Warnw("Failed to retrieve gateway endpoints on startup", "err", err)
+	}
+	gatewayEndpointsRegistry.startCacheUpdater()
+	return gatewayEndpointsRegistry
+}
+
+func (r *CachedGatewayEndpointsRegistry) GetGatewayEndpoint(apiKey string) (db_query.GetGatewayEndpointsRow, bool) {
+	r.cacheLock.RLock()
+	defer r.cacheLock.RUnlock()
+	endpoint, found := r.gatewayEndpointsCache[common.Sha256HashHex(apiKey)]
+	return endpoint, found
+}
+
+func (r *CachedGatewayEndpointsRegistry) Refresh() error {
+	gatewayEndpoints, err := r.dbQuery.GetGatewayEndpoints(context.Background())
+
+	if err != nil {
+		return err
+	}
+
+	gatewayEndpointsNew := map[string]db_query.GetGatewayEndpointsRow{}
+	for _, row := range gatewayEndpoints {
+		gatewayEndpointsNew[row.ApiKeyHash.String] = row
+	}
+
+	// Update the cache
+	r.cacheLock.Lock()
+	defer r.cacheLock.Unlock()
+	r.gatewayEndpointsCache = gatewayEndpointsNew
+	return nil
+}
+
+// startCacheUpdater starts a goroutine to periodically update the gateway endpoints cache.
+func (r *CachedGatewayEndpointsRegistry) startCacheUpdater() {
+	ticker := time.Tick(gatewayEndpointsUpdateInterval)
+	go func() {
+		for {
+			select {
+			case <-ticker:
+				err := r.Refresh()
+				if err != nil {
+					r.logger.Sugar().Warnw("failed to update gateway endpoints registry", "err", err)
+				}
+			}
+		}
+	}()
+}
+
+// IsEndpointEnabled - returns whether the endpoint is allowed to send relays.
+func IsEndpointEnabled(endpoint db_query.GetGatewayEndpointsRow) bool {
+	return endpoint.Enabled == nil || *endpoint.Enabled
+}
+
+// IsChainAllowed - returns whether the endpoint is allowed to relay to the chain, endpoints without allowed chains can relay to any chain.
+func IsChainAllowed(endpoint db_query.GetGatewayEndpointsRow, chainId string) bool {
+	return len(endpoint.AllowedChains) == 0 || slices.Contains(endpoint.AllowedChains, chainId)
+}
@@ -0,0 +1,51 @@
+package gateway_endpoints_registry
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	db_query_mock "github.com/pokt-network/gateway-server/mocks/db_query"
+	"github.com/pokt-network/gateway-server/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestGetGatewayEndpoint(t *testing.T) {
+	mockQuerier := new(db_query_mock.Querier)
+	registry := &CachedGatewayEndpointsRegistry{dbQuery: mockQuerier, gatewayEndpointsCache: map[string]db_query.GetGatewayEndpointsRow{}, logger: zap.NewNop()}
+
+	_, ok := registry.GetGatewayEndpoint("key")
+	assert.False(t, ok)
+
+	// Endpoints are looked up by the hash of their api key
+	mockQuerier.EXPECT().GetGatewayEndpoints(mock.Anything).Return([]db_query.GetGatewayEndpointsRow{{ApiKeyHash: pgtype.Varchar{String: common.Sha256HashHex("key"), Status: pgtype.Present}, Name: pgtype.Varchar{String: "endpoint", Status: pgtype.Present}}}, nil).Once()
+	assert.NoError(t, registry.Refresh())
+	endpoint, ok := registry.GetGatewayEndpoint("key")
+	assert.True(t, ok)
+	assert.Equal(t, "endpoint", endpoint.Name.String)
+
+	mockQuerier.EXPECT().GetGatewayEndpoints(mock.Anything).Return(nil, nil).Once()
+	assert.NoError(t, registry.Refresh())
+	_, ok = registry.GetGatewayEndpoint("key")
+	assert.False(t, ok)
+	mockQuerier.AssertExpectations(t)
+}
+
+func TestIsChainAllowed(t *testing.T) {
+	tests := []struct {
+		name          string
+		allowedChains []string
+		chainId       string
+		want          bool
+	}{
+		{name: "no allowed chains", allowedChains: nil, chainId: "0021", want: true},
+		{name: "allowed chain", allowedChains: []string{"0001", "0021"}, chainId: "0021", want: true},
+		{name: "not allowed chain", allowedChains: []string{"0001"}, chainId: "0021", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsChainAllowed(db_query.GetGatewayEndpointsRow{AllowedChains: tt.allowedChains}, tt.chainId))
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package gateway_endpoints_registry
+
+import "github.com/pokt-network/gateway-server/internal/db_query"
+
+type GatewayEndpointsService interface {
+	GetGatewayEndpoint(apiKey string) (db_query.GetGatewayEndpointsRow, bool)
+	// Refresh - reloads the endpoints, so that changes made through the admin endpoints apply right away.
+	Refresh() error
+}
@@ -1,7 +1,6 @@
 package global_config
 
 import (
-	"github.com/pokt-network/gateway-server/internal/chain_network"
 	"time"
 )
 
@@ -17,8 +16,8 @@ type GlobalConfigProvider interface {
 	EnvironmentProvider
 	PoktNodeConfigProvider
 	AltruistConfigProvider
+	RelayConfigProvider
 	PromMetricsProvider
-	ChainNetworkProvider
 }
 
 type PromMetricsProvider interface {
@@ -47,6 +46,7 @@ type AltruistConfigProvider interface {
 	GetAltruistRequestTimeout() time.Duration
 }
 
-type ChainNetworkProvider interface {
-	GetChainNetwork() chain_network.ChainNetwork
+type RelayConfigProvider interface {
+	GetRelayForwardedHeaders() []string
+	IsRelayAPIKeyRequired() bool
 }
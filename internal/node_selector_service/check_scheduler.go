@@ -0,0 +1,191 @@
+package node_selector_service
+
+import (
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"github.com/pokt-network/gateway-server/internal/session_registry"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// number of check jobs that can run at the same time across every session
+	checkWorkerCount = 32
+	// time a check job can hold a worker, slower jobs keep running but release their worker to the other sessions.
+	// A job is never queued again while its previous run is in flight, so runs of the same job do not overlap.
+	checkJobTimeout = time.Second * 30
+)
+
+var (
+	histogramCheckJobDuration *prometheus.HistogramVec
+	histogramCheckJobLag      *prometheus.HistogramVec
+	counterCheckJobTimeout    *prometheus.CounterVec
+)
+
+func init() {
+	histogramCheckJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 15, 20, 30, 60},
+			Name:    "check_job_duration_seconds",
+			Help:    "Time a QoS check job took to check the nodes of a session",
+		},
+		[]string{"chain_id", "check"},
+	)
+	histogramCheckJobLag = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 15, 20, 30, 60},
+			Name:    "check_job_lag_seconds",
+			Help:    "Time a due QoS check job waited for a worker",
+		},
+		[]string{"check"},
+	)
+	counterCheckJobTimeout = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "check_job_timeout_counter",
+			Help: "QoS check jobs that held a worker for longer than the job timeout",
+		},
+		[]string{"chain_id", "check"},
+	)
+	prometheus.MustRegister(histogramCheckJobDuration, histogramCheckJobLag, counterCheckJobTimeout)
+}
+
+// scheduledCheckJob - a check job of a single session. The job is only handed a new node snapshot while it is not running.
+type scheduledCheckJob struct {
+	job     checks.CheckJob
+	chainId string
+	// set when the job is queued and cleared once Perform returns, even if the job timed out and released its worker
+	running    atomic.Bool
+	queuedTime time.Time
+}
+
+// checkScheduler - runs the check jobs of every session on a bounded pool of workers. Every session has its own
+// instances of the check jobs, so a slow session only delays its own checks.
+type checkScheduler struct {
+	sessionRegistry session_registry.SessionRegistryService
+	newCheckJobs    func() []checks.CheckJob
+	// jobs of every session, only accessed by the scheduling goroutine
+	sessionJobs map[models.SessionChainKey][]*scheduledCheckJob
+	queue       chan *scheduledCheckJob
+	workerCount int
+	jobTimeout  time.Duration
+	stopping    chan struct{}
+	stopped     chan struct{}
+	workers     sync.WaitGroup
+	logger      *zap.Logger
+}
+
+func newCheckScheduler(sessionRegistry session_registry.SessionRegistryService, newCheckJobs func() []checks.CheckJob, workerCount int, jobTimeout time.Duration, logger *zap.Logger) *checkScheduler {
+	return &checkScheduler{
+		sessionRegistry: sessionRegistry,
+		newCheckJobs:    newCheckJobs,
+		sessionJobs:     map[models.SessionChainKey][]*scheduledCheckJob{},
+		queue:           make(chan *scheduledCheckJob, workerCount),
+		workerCount:     workerCount,
+		jobTimeout:      jobTimeout,
+		stopping:        make(chan struct{}),
+		stopped:         make(chan struct{}),
+		logger:          logger,
+	}
+}
+
+// start - starts the workers and the goroutine that queues the due jobs of every session.
+func (s *checkScheduler) start() {
+	s.workers.Add(s.workerCount)
+	for i := 0; i < s.workerCount; i++ {
+		go func() {
+			defer s.workers.Done()
+			s.runWorker()
+		}()
+	}
+	ticker := time.NewTicker(jobCheckInterval)
+	go func() {
+		defer close(s.stopped)
+		defer close(s.queue)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stopping:
+				return
+			case <-ticker.C:
+				s.scheduleJobs()
+			}
+		}
+	}()
+}
+
+// stop - stops queueing jobs and waits for the workers to finish the queued jobs. Jobs that time out are not waited for.
+func (s *checkScheduler) stop() {
+	close(s.stopping)
+	<-s.stopped
+	s.workers.Wait()
+}
+
+// scheduleJobs - queues the jobs that are due and not already running, and drops the jobs of expired sessions.
+// Queueing blocks while every worker is busy, which shows up as lag of the queued jobs.
+func (s *checkScheduler) scheduleJobs() {
+	nodesMap := s.sessionRegistry.GetNodesMap()
+	for sessionKey := range s.sessionJobs {
+		if _, ok := nodesMap[sessionKey]; !ok {
+			delete(s.sessionJobs, sessionKey)
+		}
+	}
+	for sessionKey, nodes := range nodesMap {
+		jobs, ok := s.sessionJobs[sessionKey]
+		if !ok {
+			jobs = s.newSessionJobs(sessionKey)
+			s.sessionJobs[sessionKey] = jobs
+		}
+		for _, job := range jobs {
+			if job.running.Load() || !job.job.ShouldRun() {
+				continue
+			}
+			job.running.Store(true)
+			// Nodes can be appended to the session, so every run gets its own copy of the node list
+			job.job.SetNodes(append([]*models.QosNode(nil), nodes.Value()...))
+			job.queuedTime = time.Now()
+			select {
+			case s.queue <- job:
+			case <-s.stopping:
+				return
+			}
+		}
+	}
+}
+
+func (s *checkScheduler) newSessionJobs(sessionKey models.SessionChainKey) []*scheduledCheckJob {
+	var jobs []*scheduledCheckJob
+	for _, job := range s.newCheckJobs() {
+		jobs = append(jobs, &scheduledCheckJob{job: job, chainId: sessionKey.Chain})
+	}
+	return jobs
+}
+
+func (s *checkScheduler) runWorker() {
+	for job := range s.queue {
+		histogramCheckJobLag.WithLabelValues(job.job.Name()).Observe(time.Since(job.queuedTime).Seconds())
+		s.performJob(job)
+	}
+}
+
+// performJob - runs the job until it finishes or times out. Jobs cannot be cancelled, so a job that times out keeps
+// running in the background and stays marked as running, which keeps scheduleJobs from queueing an overlapping run
+// of the job until it finishes.
+func (s *checkScheduler) performJob(job *scheduledCheckJob) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		startTime := time.Now()
+		job.job.Perform()
+		histogramCheckJobDuration.WithLabelValues(job.chainId, job.job.Name()).Observe(time.Since(startTime).Seconds())
+		job.running.Store(false)
+	}()
+	select {
+	case <-done:
+	case <-time.After(s.jobTimeout):
+		counterCheckJobTimeout.WithLabelValues(job.chainId, job.job.Name()).Inc()
+		s.logger.Sugar().Warnw("check job timed out, releasing its worker", "chain", job.chainId, "check", job.job.Name(), "timeout", s.jobTimeout)
+	}
+}
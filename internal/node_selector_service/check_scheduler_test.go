@@ -0,0 +1,170 @@
+package node_selector_service
+
+import (
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	session_registry_mock "github.com/pokt-network/gateway-server/mocks/session_registry"
+	pokt_models "github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeCheckJob reports the nodes of every run, and holds the run until released if it is blocking
+type fakeCheckJob struct {
+	nodes     []*models.QosNode
+	performed chan []*models.QosNode
+	release   chan struct{}
+	// runs in flight and the most runs that were ever in flight at once
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (f *fakeCheckJob) Perform() {
+	inFlight := f.inFlight.Add(1)
+	defer f.inFlight.Add(-1)
+	if inFlight > f.maxInFlight.Load() {
+		f.maxInFlight.Store(inFlight)
+	}
+	f.performed <- f.nodes
+	if f.release != nil {
+		<-f.release
+	}
+}
+
+func (f *fakeCheckJob) Name() string {
+	return "fake_check"
+}
+
+func (f *fakeCheckJob) ShouldRun() bool {
+	return true
+}
+
+func (f *fakeCheckJob) SetNodes(nodes []*models.QosNode) {
+	f.nodes = nodes
+}
+
+func newTestSessionNodes(sessions map[models.SessionChainKey][]*models.QosNode) map[models.SessionChainKey]*ttlcache.Item[models.SessionChainKey, []*models.QosNode] {
+	cache := ttlcache.New[models.SessionChainKey, []*models.QosNode]()
+	for sessionKey, nodes := range sessions {
+		cache.Set(sessionKey, nodes, ttlcache.NoTTL)
+	}
+	return cache.Items()
+}
+
+// newTestScheduler - creates a scheduler with a single fake job per session, the first session's job blocks if a release channel is provided
+func newTestScheduler(sessionRegistry *session_registry_mock.SessionRegistryService, workerCount int, release chan struct{}) *checkScheduler {
+	newCheckJobs := func() []checks.CheckJob {
+		job := &fakeCheckJob{performed: make(chan []*models.QosNode, 10), release: release}
+		release = nil
+		return []checks.CheckJob{job}
+	}
+	scheduler := newCheckScheduler(sessionRegistry, newCheckJobs, workerCount, time.Millisecond*50, zap.NewNop())
+	for i := 0; i < workerCount; i++ {
+		go scheduler.runWorker()
+	}
+	return scheduler
+}
+
+func receiveNodes(t *testing.T, performed chan []*models.QosNode) []*models.QosNode {
+	select {
+	case nodes := <-performed:
+		return nodes
+	case <-time.After(time.Second):
+		t.Fatal("check job was not performed")
+		return nil
+	}
+}
+
+func TestCheckSchedulerRunsJobsPerSession(t *testing.T) {
+	firstSession := models.SessionChainKey{SessionHeight: 1, Chain: "0021"}
+	secondSession := models.SessionChainKey{SessionHeight: 1, Chain: "0001"}
+	firstNode := models.NewQosNode(&pokt_models.Node{PublicKey: "first"}, &pokt_models.Session{}, &pokt_models.Ed25519Account{})
+	secondNode := models.NewQosNode(&pokt_models.Node{PublicKey: "second"}, &pokt_models.Session{}, &pokt_models.Ed25519Account{})
+	sessionRegistry := new(session_registry_mock.SessionRegistryService)
+	sessionRegistry.EXPECT().GetNodesMap().Return(newTestSessionNodes(map[models.SessionChainKey][]*models.QosNode{
+		firstSession:  {firstNode},
+		secondSession: {secondNode},
+	}))
+	scheduler := newTestScheduler(sessionRegistry, 2, nil)
+
+	scheduler.scheduleJobs()
+
+	assert.Len(t, scheduler.sessionJobs, 2)
+	firstJob := scheduler.sessionJobs[firstSession][0].job.(*fakeCheckJob)
+	secondJob := scheduler.sessionJobs[secondSession][0].job.(*fakeCheckJob)
+	assert.NotSame(t, firstJob, secondJob)
+	assert.Equal(t, []*models.QosNode{firstNode}, receiveNodes(t, firstJob.performed))
+	assert.Equal(t, []*models.QosNode{secondNode}, receiveNodes(t, secondJob.performed))
+}
+
+func TestCheckSchedulerReleasesWorkerOfSlowJob(t *testing.T) {
+	slowSession := models.SessionChainKey{SessionHeight: 1, Chain: "0021"}
+	otherSession := models.SessionChainKey{SessionHeight: 1, Chain: "0001"}
+	sessionRegistry := new(session_registry_mock.SessionRegistryService)
+	sessionRegistry.EXPECT().GetNodesMap().Return(newTestSessionNodes(map[models.SessionChainKey][]*models.QosNode{slowSession: {}})).Once()
+	sessionRegistry.EXPECT().GetNodesMap().Return(newTestSessionNodes(map[models.SessionChainKey][]*models.QosNode{slowSession: {}, otherSession: {}}))
+	release := make(chan struct{})
+	scheduler := newTestScheduler(sessionRegistry, 1, release)
+
+	scheduler.scheduleJobs()
+	slowJob := scheduler.sessionJobs[slowSession][0].job.(*fakeCheckJob)
+	receiveNodes(t, slowJob.performed)
+
+	// The other session's job runs once the slow job times out, the slow job is not queued again while it is running
+	scheduler.scheduleJobs()
+	otherJob := scheduler.sessionJobs[otherSession][0].job.(*fakeCheckJob)
+	receiveNodes(t, otherJob.performed)
+	assert.Empty(t, slowJob.performed)
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		return !scheduler.sessionJobs[slowSession][0].running.Load()
+	}, time.Second, time.Millisecond*10)
+	scheduler.scheduleJobs()
+	receiveNodes(t, slowJob.performed)
+}
+
+func TestCheckSchedulerDoesNotOverlapTimedOutJob(t *testing.T) {
+	slowSession := models.SessionChainKey{SessionHeight: 1, Chain: "0021"}
+	sessionRegistry := new(session_registry_mock.SessionRegistryService)
+	sessionRegistry.EXPECT().GetNodesMap().Return(newTestSessionNodes(map[models.SessionChainKey][]*models.QosNode{slowSession: {}}))
+	release := make(chan struct{})
+	scheduler := newTestScheduler(sessionRegistry, 2, release)
+
+	scheduler.scheduleJobs()
+	slowJob := scheduler.sessionJobs[slowSession][0].job.(*fakeCheckJob)
+	receiveNodes(t, slowJob.performed)
+
+	// Every tick after the job timed out finds its previous run still in flight
+	for i := 0; i < 3; i++ {
+		time.Sleep(scheduler.jobTimeout)
+		scheduler.scheduleJobs()
+	}
+	assert.Empty(t, slowJob.performed)
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		return !scheduler.sessionJobs[slowSession][0].running.Load()
+	}, time.Second, time.Millisecond*10)
+	scheduler.scheduleJobs()
+	receiveNodes(t, slowJob.performed)
+	assert.Equal(t, int32(1), slowJob.maxInFlight.Load())
+}
+
+func TestCheckSchedulerDropsExpiredSessions(t *testing.T) {
+	expiredSession := models.SessionChainKey{SessionHeight: 1, Chain: "0021"}
+	sessionRegistry := new(session_registry_mock.SessionRegistryService)
+	sessionRegistry.EXPECT().GetNodesMap().Return(newTestSessionNodes(map[models.SessionChainKey][]*models.QosNode{expiredSession: {}})).Once()
+	sessionRegistry.EXPECT().GetNodesMap().Return(newTestSessionNodes(map[models.SessionChainKey][]*models.QosNode{}))
+	scheduler := newTestScheduler(sessionRegistry, 1, nil)
+
+	scheduler.scheduleJobs()
+	assert.Contains(t, scheduler.sessionJobs, expiredSession)
+
+	scheduler.scheduleJobs()
+	assert.NotContains(t, scheduler.sessionJobs, expiredSession)
+}
@@ -0,0 +1,70 @@
+package bitcoin_data_integrity_check
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	// how often the job should run
+	dataIntegrityCheckInterval = time.Second * 1
+
+	//json rpc payload to send a data integrity check
+	blockHashPayloadFmt = `{"jsonrpc":"1.0","method":"getblockhash","params":[%d],"id":1}`
+)
+
+type blockHashResponse struct {
+	Result string `json:"result"`
+}
+
+type BitcoinDataIntegrityCheck struct {
+	*checks.Check
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewBitcoinDataIntegrityCheck(check *checks.Check, logger *zap.Logger) *BitcoinDataIntegrityCheck {
+	return &BitcoinDataIntegrityCheck{Check: check, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *BitcoinDataIntegrityCheck) Name() string {
+	return "bitcoin_data_integrity_check"
+}
+
+func (c *BitcoinDataIntegrityCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *BitcoinDataIntegrityCheck) Perform() {
+
+	// Session is not meant for Bitcoin
+	if len(c.NodeList) == 0 || c.GetChainFamily(c.NodeList[0]) != checks.ChainFamilyBitcoin {
+		return
+	}
+	checks.PerformDataIntegrityCheck(c.Check, getBlockByNumberRequest, getBlockHashFromNodeResponse, c.logger)
+	c.nextCheckTime = time.Now().Add(dataIntegrityCheckInterval)
+}
+
+func (c *BitcoinDataIntegrityCheck) ShouldRun() bool {
+	return c.nextCheckTime.IsZero() || time.Now().After(c.nextCheckTime)
+}
+
+func getBlockByNumberRequest(blockNumber uint64) checks.BlockRequest {
+	return checks.BlockRequest{Method: "POST", Payload: fmt.Sprintf(blockHashPayloadFmt, blockNumber)}
+}
+
+func getBlockHashFromNodeResponse(response string) (string, error) {
+	var blockHashRsp blockHashResponse
+	if err := json.Unmarshal([]byte(response), &blockHashRsp); err != nil {
+		return "", err
+	}
+	if blockHashRsp.Result == "" {
+		return "", errors.New("missing block hash")
+	}
+	return blockHashRsp.Result, nil
+}
@@ -0,0 +1,33 @@
+package bitcoin_data_integrity_check
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetBlockHashFromNodeResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "block hash",
+			response: `{"result":"00000000000000000001b8c0f7a8a2d1ad6e1c6b29a1f8ed4d8a3d6c8b3a9f12","error":null,"id":1}`,
+			expected: "00000000000000000001b8c0f7a8a2d1ad6e1c6b29a1f8ed4d8a3d6c8b3a9f12",
+		},
+		{
+			name:     "height out of range",
+			response: `{"result":null,"error":{"code":-8,"message":"Block height out of range"},"id":1}`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := getBlockHashFromNodeResponse(tt.response)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, hash)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package bitcoin_height_check
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+
+	// interval to run the bitcoin height check
+	bitcoinHeightCheckInterval = time.Second * 1
+
+	// jsonrpc payload to retrieve the bitcoin block count
+	HeightJsonPayload = `{"jsonrpc":"1.0","method":"getblockcount","params":[],"id":1}`
+)
+
+type blockCountResponse struct {
+	Result *uint64 `json:"result"`
+}
+
+type BitcoinHeightCheck struct {
+	*checks.Check
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewBitcoinHeightCheck(check *checks.Check, logger *zap.Logger) *BitcoinHeightCheck {
+	return &BitcoinHeightCheck{Check: check, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *BitcoinHeightCheck) Name() string {
+	return "bitcoin_height_check"
+}
+
+func (c *BitcoinHeightCheck) Perform() {
+
+	// Session is not meant for Bitcoin
+	if len(c.NodeList) == 0 || c.GetChainFamily(c.NodeList[0]) != checks.ChainFamilyBitcoin {
+		return
+	}
+	checks.PerformDefaultHeightCheck(c.Check, "POST", HeightJsonPayload, "", ParseHeight, c.logger)
+	c.nextCheckTime = time.Now().Add(bitcoinHeightCheckInterval)
+}
+
+func (c *BitcoinHeightCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *BitcoinHeightCheck) ShouldRun() bool {
+	return time.Now().After(c.nextCheckTime)
+}
+
+// ParseHeight - parses the height from the response to HeightJsonPayload, also used to probe altruists.
+func ParseHeight(response string) (uint64, error) {
+	var blockCountRsp blockCountResponse
+	if err := json.Unmarshal([]byte(response), &blockCountRsp); err != nil {
+		return 0, err
+	}
+	if blockCountRsp.Result == nil {
+		return 0, errors.New("missing block count")
+	}
+	return *blockCountRsp.Result, nil
+}
@@ -0,0 +1,38 @@
+package bitcoin_height_check
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseHeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected uint64
+		wantErr  bool
+	}{
+		{
+			name:     "block count",
+			response: `{"result":842511,"error":null,"id":1}`,
+			expected: 842511,
+		},
+		{
+			name:     "warming up",
+			response: `{"result":null,"error":{"code":-28,"message":"Loading block index..."},"id":1}`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid json",
+			response: `<html>Bad Gateway</html>`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			height, err := ParseHeight(tt.response)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, height)
+		})
+	}
+}
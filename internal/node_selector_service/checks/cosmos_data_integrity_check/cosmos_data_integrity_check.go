@@ -0,0 +1,107 @@
+package cosmos_data_integrity_check
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	// how often the job should run
+	dataIntegrityCheckInterval = time.Second * 1
+
+	// path of the Tendermint RPC block request
+	blockPathFmt = "/block?height=%d"
+
+	// path of the LCD block request
+	lcdBlockPathFmt = "/cosmos/base/tendermint/v1beta1/blocks/%d"
+)
+
+type blockId struct {
+	Hash string `json:"hash"`
+}
+
+type blockResponse struct {
+	Result struct {
+		BlockId blockId `json:"block_id"`
+	} `json:"result"`
+}
+
+type lcdBlockResponse struct {
+	BlockId blockId `json:"block_id"`
+}
+
+type CosmosDataIntegrityCheck struct {
+	*checks.Check
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewCosmosDataIntegrityCheck(check *checks.Check, logger *zap.Logger) *CosmosDataIntegrityCheck {
+	return &CosmosDataIntegrityCheck{Check: check, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *CosmosDataIntegrityCheck) Name() string {
+	return "cosmos_data_integrity_check"
+}
+
+func (c *CosmosDataIntegrityCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *CosmosDataIntegrityCheck) Perform() {
+
+	// Session is not meant for Cosmos
+	if len(c.NodeList) == 0 {
+		return
+	}
+	switch c.GetChainFamily(c.NodeList[0]) {
+	case checks.ChainFamilyCosmos:
+		checks.PerformDataIntegrityCheck(c.Check, getBlockByNumberRequest, getBlockHashFromNodeResponse, c.logger)
+	case checks.ChainFamilyCosmosLcd:
+		checks.PerformDataIntegrityCheck(c.Check, getLcdBlockByNumberRequest, getLcdBlockHashFromNodeResponse, c.logger)
+	default:
+		return
+	}
+	c.nextCheckTime = time.Now().Add(dataIntegrityCheckInterval)
+}
+
+func (c *CosmosDataIntegrityCheck) ShouldRun() bool {
+	return c.nextCheckTime.IsZero() || time.Now().After(c.nextCheckTime)
+}
+
+func getBlockByNumberRequest(blockNumber uint64) checks.BlockRequest {
+	return checks.BlockRequest{Method: "GET", Path: fmt.Sprintf(blockPathFmt, blockNumber)}
+}
+
+func getLcdBlockByNumberRequest(blockNumber uint64) checks.BlockRequest {
+	return checks.BlockRequest{Method: "GET", Path: fmt.Sprintf(lcdBlockPathFmt, blockNumber)}
+}
+
+func getBlockHashFromNodeResponse(response string) (string, error) {
+	var blockRsp blockResponse
+	if err := json.Unmarshal([]byte(response), &blockRsp); err != nil {
+		return "", err
+	}
+	return getBlockHash(blockRsp.Result.BlockId)
+}
+
+func getLcdBlockHashFromNodeResponse(response string) (string, error) {
+	var blockRsp lcdBlockResponse
+	if err := json.Unmarshal([]byte(response), &blockRsp); err != nil {
+		return "", err
+	}
+	return getBlockHash(blockRsp.BlockId)
+}
+
+// getBlockHash - returns the hash of the block, nodes that answer with an error such as a pruned block do not have one.
+func getBlockHash(id blockId) (string, error) {
+	if id.Hash == "" {
+		return "", errors.New("missing block hash")
+	}
+	return id.Hash, nil
+}
@@ -0,0 +1,73 @@
+package cosmos_data_integrity_check
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	chain_configurations_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_configurations_registry"
+	pocket_service_mock "github.com/pokt-network/gateway-server/mocks/pocket_service"
+	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestCosmosDataIntegrityCheck(t *testing.T) {
+	tests := []struct {
+		name          string
+		chainFamily   string
+		expectedPath  string
+		nodeResponses map[string]string
+	}{
+		{
+			name:         "tendermint rpc",
+			chainFamily:  "cosmos",
+			expectedPath: "/block?height=975",
+			nodeResponses: map[string]string{
+				"first":  `{"jsonrpc":"2.0","id":-1,"result":{"block_id":{"hash":"6B2A1C"},"block":{"header":{"height":"975"}}}}`,
+				"second": `{"jsonrpc":"2.0","id":-1,"result":{"block_id":{"hash":"6B2A1C"},"block":{"header":{"height":"975"}}}}`,
+				"forked": `{"jsonrpc":"2.0","id":-1,"result":{"block_id":{"hash":"F00D00"},"block":{"header":{"height":"975"}}}}`,
+			},
+		},
+		{
+			name:         "lcd",
+			chainFamily:  "cosmos_lcd",
+			expectedPath: "/cosmos/base/tendermint/v1beta1/blocks/975",
+			nodeResponses: map[string]string{
+				"first":  `{"block_id":{"hash":"ayocHA=="},"block":{"header":{"height":"975"}}}`,
+				"second": `{"block_id":{"hash":"ayocHA=="},"block":{"header":{"height":"975"}}}`,
+				"forked": `{"block_id":{"hash":"8A0NAA=="},"block":{"header":{"height":"975"}}}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chainFamily := pgtype.Varchar{}
+			chainFamily.Set(tt.chainFamily)
+			lookbackHeight := int32(25)
+
+			pocketService := new(pocket_service_mock.PocketService)
+			pocketService.EXPECT().SendRelay(mock.Anything).RunAndReturn(func(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
+				assert.Equal(t, "GET", req.Payload.Method)
+				assert.Equal(t, tt.expectedPath, req.Payload.Path)
+				return &models.SendRelayResponse{Response: tt.nodeResponses[req.SelectedNodePubKey]}, nil
+			})
+			chainConfiguration := new(chain_configurations_registry_mock.ChainConfigurationsService)
+			chainConfiguration.EXPECT().GetChainConfiguration("0003").Return(db_query.GetChainConfigurationsRow{ChainFamily: chainFamily, DataIntegrityCheckLookbackHeight: &lookbackHeight}, true)
+
+			firstNode := qos_models.NewTestQosNode("first", "0003", qos_models.WithSynced(), qos_models.WithLastKnownHeight(1000))
+			secondNode := qos_models.NewTestQosNode("second", "0003", qos_models.WithSynced(), qos_models.WithLastKnownHeight(1000))
+			forkedNode := qos_models.NewTestQosNode("forked", "0003", qos_models.WithSynced(), qos_models.WithLastKnownHeight(1000))
+			check := NewCosmosDataIntegrityCheck(checks.NewCheck(pocketService, chainConfiguration), zap.NewNop())
+			check.SetNodes([]*qos_models.QosNode{firstNode, secondNode, forkedNode})
+			check.Perform()
+
+			assert.False(t, firstNode.IsInTimeout())
+			assert.False(t, secondNode.IsInTimeout())
+			assert.True(t, forkedNode.IsInTimeout())
+			assert.Equal(t, qos_models.DataIntegrityTimeout, forkedNode.GetTimeoutReason())
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package cosmos_height_check
+
+import (
+	"encoding/json"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"go.uber.org/zap"
+	"strconv"
+	"time"
+)
+
+const (
+
+	// interval to run the cosmos height check
+	cosmosHeightCheckInterval = time.Second * 1
+
+	// path of the Tendermint RPC status request
+	HeightPath = "/status"
+
+	// path of the LCD latest block request
+	LcdHeightPath = "/cosmos/base/tendermint/v1beta1/blocks/latest"
+)
+
+type statusResponse struct {
+	Result struct {
+		SyncInfo struct {
+			LatestBlockHeight string `json:"latest_block_height"`
+		} `json:"sync_info"`
+	} `json:"result"`
+}
+
+type lcdBlockResponse struct {
+	Block struct {
+		Header struct {
+			Height string `json:"height"`
+		} `json:"header"`
+	} `json:"block"`
+}
+
+type CosmosHeightCheck struct {
+	*checks.Check
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewCosmosHeightCheck(check *checks.Check, logger *zap.Logger) *CosmosHeightCheck {
+	return &CosmosHeightCheck{Check: check, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *CosmosHeightCheck) Name() string {
+	return "cosmos_height_check"
+}
+
+func (c *CosmosHeightCheck) Perform() {
+
+	// Session is not meant for Cosmos
+	if len(c.NodeList) == 0 {
+		return
+	}
+	switch c.GetChainFamily(c.NodeList[0]) {
+	case checks.ChainFamilyCosmos:
+		checks.PerformDefaultHeightCheck(c.Check, "GET", "", HeightPath, ParseHeight, c.logger)
+	case checks.ChainFamilyCosmosLcd:
+		checks.PerformDefaultHeightCheck(c.Check, "GET", "", LcdHeightPath, ParseLcdHeight, c.logger)
+	default:
+		return
+	}
+	c.nextCheckTime = time.Now().Add(cosmosHeightCheckInterval)
+}
+
+func (c *CosmosHeightCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *CosmosHeightCheck) ShouldRun() bool {
+	return time.Now().After(c.nextCheckTime)
+}
+
+// ParseHeight - parses the height from the response to the Tendermint RPC status request, also used to probe altruists.
+func ParseHeight(response string) (uint64, error) {
+	var statusRsp statusResponse
+	if err := json.Unmarshal([]byte(response), &statusRsp); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(statusRsp.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// ParseLcdHeight - parses the height from the response to the LCD latest block request, also used to probe altruists.
+func ParseLcdHeight(response string) (uint64, error) {
+	var blockRsp lcdBlockResponse
+	if err := json.Unmarshal([]byte(response), &blockRsp); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(blockRsp.Block.Header.Height, 10, 64)
+}
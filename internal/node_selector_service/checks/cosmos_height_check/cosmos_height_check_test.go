@@ -0,0 +1,48 @@
+package cosmos_height_check
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseHeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		parse    func(response string) (uint64, error)
+		response string
+		expected uint64
+		wantErr  bool
+	}{
+		{
+			name:     "tendermint status",
+			parse:    ParseHeight,
+			response: `{"jsonrpc":"2.0","id":-1,"result":{"node_info":{"network":"cosmoshub-4"},"sync_info":{"latest_block_hash":"6B2A1C","latest_block_height":"19864201","catching_up":false}}}`,
+			expected: 19864201,
+		},
+		{
+			name:     "tendermint error",
+			parse:    ParseHeight,
+			response: `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error"}}`,
+			wantErr:  true,
+		},
+		{
+			name:     "lcd latest block",
+			parse:    ParseLcdHeight,
+			response: `{"block_id":{"hash":"ayocHA=="},"block":{"header":{"chain_id":"osmosis-1","height":"14201532"}}}`,
+			expected: 14201532,
+		},
+		{
+			name:     "lcd error",
+			parse:    ParseLcdHeight,
+			response: `{"code":12,"message":"Not Implemented","details":[]}`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			height, err := tt.parse(tt.response)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, height)
+		})
+	}
+}
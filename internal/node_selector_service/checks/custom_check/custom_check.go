@@ -0,0 +1,211 @@
+package custom_check
+
+import (
+	"fmt"
+	"github.com/pokt-network/gateway-server/internal/chain_checks_registry"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// interval to look for nodes that are due for one of their chain's checks, each check has its own interval per node
+	customCheckInterval = time.Second * 1
+
+	// placeholder of payloads and paths that is replaced by the height of a healthy node minus the chain's data integrity
+	// lookback, so that majority checks can compare a recent block that every node has
+	HeightPlaceholder = "{{height}}"
+
+	defaultHeightTolerance = 100
+	defaultHeightLookback  = 25
+	maxLoggedValueLength   = 100
+)
+
+type nodeValue struct {
+	node  *models.QosNode
+	value string
+}
+
+// CustomCheck runs the checks that operators define per chain in the chain_checks table.
+type CustomCheck struct {
+	*checks.Check
+	chainChecks   chain_checks_registry.ChainChecksService
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewCustomCheck(check *checks.Check, chainChecks chain_checks_registry.ChainChecksService, logger *zap.Logger) *CustomCheck {
+	return &CustomCheck{Check: check, chainChecks: chainChecks, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *CustomCheck) Name() string {
+	return "custom_check"
+}
+
+func (c *CustomCheck) Perform() {
+	if len(c.NodeList) == 0 {
+		return
+	}
+	c.nextCheckTime = time.Now().Add(customCheckInterval)
+	for _, chainCheck := range c.chainChecks.GetChainChecks(c.NodeList[0].GetChain()) {
+		c.performChainCheck(chainCheck)
+	}
+}
+
+func (c *CustomCheck) performChainCheck(chainCheck *chain_checks_registry.ChainCheck) {
+	nodes := getEligibleNodes(c.NodeList, chainCheck)
+	if len(nodes) == 0 {
+		return
+	}
+	payload, path, ok := c.getRequest(chainCheck)
+	if !ok {
+		return
+	}
+
+	var nodeValues []*nodeValue
+	for rsp := range checks.SendRelaysAsync(c.PocketRelayer, nodes, payload, chainCheck.Method, path) {
+		if rsp.Error != nil {
+			checks.DefaultPunishNode(rsp.Error, rsp.Node, c.logger)
+			continue
+		}
+		value, err := chainCheck.JsonPath.ExtractString([]byte(rsp.Relay.Response))
+		if err != nil {
+			c.logger.Sugar().Warnw("failed to extract custom check value", "check", chainCheck.Name, "node", rsp.Node.MorseNode.ServiceUrl, "response", truncate(rsp.Relay.Response), "err", err)
+			// Treat an invalid response as a timeout error
+			checks.DefaultPunishNode(fasthttp.ErrTimeout, rsp.Node, c.logger)
+			continue
+		}
+		rsp.Node.SetLastCustomCheckTime(chainCheck.ID, time.Now())
+		nodeValues = append(nodeValues, &nodeValue{node: rsp.Node, value: value})
+	}
+
+	switch chainCheck.Comparison {
+	case chain_checks_registry.ComparisonHeightTolerance:
+		c.compareHeights(chainCheck, nodeValues)
+	case chain_checks_registry.ComparisonMajority:
+		c.compareMajority(chainCheck, nodeValues)
+	case chain_checks_registry.ComparisonExpectedValue:
+		c.compareExpectedValue(chainCheck, nodeValues)
+	}
+}
+
+// getRequest - returns the payload and path of the check, with the height placeholder replaced. Checks with a height
+// placeholder are skipped until a node of the session is healthy.
+func (c *CustomCheck) getRequest(chainCheck *chain_checks_registry.ChainCheck) (string, string, bool) {
+	if !strings.Contains(chainCheck.Payload, HeightPlaceholder) && !strings.Contains(chainCheck.Path, HeightPlaceholder) {
+		return chainCheck.Payload, chainCheck.Path, true
+	}
+	sourceOfTruth := checks.FindRandomHealthyNode(c.NodeList)
+	if sourceOfTruth == nil {
+		c.logger.Sugar().Warnw("cannot find source of truth for custom check", "check", chainCheck.Name, "chain", chainCheck.ChainID)
+		return "", "", false
+	}
+	lookback := uint64(checks.GetDataIntegrityHeightLookback(c.ChainConfiguration, chainCheck.ChainID, defaultHeightLookback))
+	height := strconv.FormatUint(sourceOfTruth.GetLastKnownHeight()-min(lookback, sourceOfTruth.GetLastKnownHeight()), 10)
+	return strings.ReplaceAll(chainCheck.Payload, HeightPlaceholder, height), strings.ReplaceAll(chainCheck.Path, HeightPlaceholder, height), true
+}
+
+// compareHeights - updates the nodes' heights and punishes nodes that are further behind the highest node than the tolerance.
+func (c *CustomCheck) compareHeights(chainCheck *chain_checks_registry.ChainCheck, nodeValues []*nodeValue) {
+	var nodesResponded []*models.QosNode
+	for _, nodeValue := range nodeValues {
+		height, err := parseHeight(nodeValue.value)
+		if err != nil {
+			c.logger.Sugar().Warnw("custom check value is not a height", "check", chainCheck.Name, "node", nodeValue.node.MorseNode.ServiceUrl, "value", truncate(nodeValue.value))
+			checks.DefaultPunishNode(fasthttp.ErrTimeout, nodeValue.node, c.logger)
+			continue
+		}
+		nodeValue.node.SetLastKnownHeight(height)
+		nodesResponded = append(nodesResponded, nodeValue.node)
+	}
+
+	var tolerance int
+	if chainCheck.HeightTolerance != nil {
+		tolerance = *chainCheck.HeightTolerance
+	} else {
+		tolerance = checks.GetBlockHeightTolerance(c.ChainConfiguration, chainCheck.ChainID, defaultHeightTolerance)
+	}
+	highestNodeHeight := checks.GetHighestNodeHeight(nodesResponded)
+	for _, node := range nodesResponded {
+		heightDifference := int(highestNodeHeight - node.GetLastKnownHeight())
+		if heightDifference > tolerance {
+			c.logger.Sugar().Infow("node is out of sync", "check", chainCheck.Name, "node", node.MorseNode.ServiceUrl, "heightDifference", heightDifference, "chain", chainCheck.ChainID)
+			node.SetSynced(false)
+			node.SetTimeoutUntil(time.Now().Add(chainCheck.Penalty), models.OutOfSyncTimeout, fmt.Errorf("%s heightDifference: %d, nodeSyncedHeight: %d, highestNodeHeight: %d", chainCheck.Name, heightDifference, node.GetLastKnownHeight(), highestNodeHeight))
+		} else {
+			node.SetSynced(true)
+		}
+	}
+}
+
+// compareMajority - punishes nodes whose value differs from the value most nodes agree on.
+func (c *CustomCheck) compareMajority(chainCheck *chain_checks_registry.ChainCheck, nodeValues []*nodeValue) {
+	valueCounts := map[string]int{}
+	for _, nodeValue := range nodeValues {
+		valueCounts[nodeValue.value]++
+	}
+	majorityValue := checks.FindMajorityBlockIdentifier(valueCounts)
+	if majorityValue == "" {
+		return
+	}
+	for _, nodeValue := range nodeValues {
+		if nodeValue.value != majorityValue {
+			c.logger.Sugar().Errorw("punishing node for failed custom check", "check", chainCheck.Name, "node", nodeValue.node.MorseNode.ServiceUrl, "value", truncate(nodeValue.value), "majorityValue", truncate(majorityValue))
+			nodeValue.node.SetTimeoutUntil(time.Now().Add(chainCheck.Penalty), models.DataIntegrityTimeout, fmt.Errorf("%s value %s, majority value %s", chainCheck.Name, truncate(nodeValue.value), truncate(majorityValue)))
+		}
+	}
+}
+
+// compareExpectedValue - punishes nodes whose value differs from the check's expected value.
+func (c *CustomCheck) compareExpectedValue(chainCheck *chain_checks_registry.ChainCheck, nodeValues []*nodeValue) {
+	for _, nodeValue := range nodeValues {
+		if nodeValue.value != chainCheck.ExpectedValue {
+			c.logger.Sugar().Errorw("punishing node for failed custom check", "check", chainCheck.Name, "node", nodeValue.node.MorseNode.ServiceUrl, "value", truncate(nodeValue.value), "expectedValue", chainCheck.ExpectedValue)
+			nodeValue.node.SetTimeoutUntil(time.Now().Add(chainCheck.Penalty), models.UnexpectedValueTimeout, fmt.Errorf("%s value %s, expected value %s", chainCheck.Name, truncate(nodeValue.value), chainCheck.ExpectedValue))
+		}
+	}
+}
+
+func (c *CustomCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *CustomCheck) ShouldRun() bool {
+	return time.Now().After(c.nextCheckTime)
+}
+
+// getEligibleNodes - returns the nodes that are due for the check. Height checks run against every node so that nodes
+// can recover from being out of sync, the other checks skip nodes in a timeout like the data integrity check does.
+func getEligibleNodes(nodes []*models.QosNode, chainCheck *chain_checks_registry.ChainCheck) []*models.QosNode {
+	var eligibleNodes []*models.QosNode
+	for _, node := range nodes {
+		lastCheckTime := node.GetLastCustomCheckTime(chainCheck.ID)
+		if !lastCheckTime.IsZero() && time.Since(lastCheckTime) < chainCheck.Interval {
+			continue
+		}
+		if chainCheck.Comparison != chain_checks_registry.ComparisonHeightTolerance && node.IsInTimeout() {
+			continue
+		}
+		eligibleNodes = append(eligibleNodes, node)
+	}
+	return eligibleNodes
+}
+
+// parseHeight - parses a height as a number, a decimal string or a 0x prefixed hex string.
+func parseHeight(value string) (uint64, error) {
+	if hexHeight, ok := strings.CutPrefix(value, "0x"); ok {
+		return strconv.ParseUint(hexHeight, 16, 64)
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+func truncate(value string) string {
+	if len(value) <= maxLoggedValueLength {
+		return value
+	}
+	return value[:maxLoggedValueLength] + "..."
+}
@@ -0,0 +1,132 @@
+package custom_check
+
+import (
+	"github.com/pokt-network/gateway-server/internal/chain_checks_registry"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	chain_checks_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_checks_registry"
+	chain_configurations_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_configurations_registry"
+	pocket_service_mock "github.com/pokt-network/gateway-server/mocks/pocket_service"
+	"github.com/pokt-network/gateway-server/pkg/common"
+	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestCustomCheck(t *testing.T) {
+	heightTolerance := 5
+	tests := []struct {
+		name            string
+		chainCheck      *chain_checks_registry.ChainCheck
+		expectedPath    string
+		nodeResponses   map[string]string
+		expectedTimeout map[string]qos_models.TimeoutReason
+	}{
+		{
+			name: "height tolerance",
+			chainCheck: &chain_checks_registry.ChainCheck{
+				ID:              "height",
+				Method:          "GET",
+				Path:            "/status",
+				JsonPath:        mustParseJsonPath("$.result.sync_info.latest_block_height"),
+				Comparison:      chain_checks_registry.ComparisonHeightTolerance,
+				HeightTolerance: &heightTolerance,
+			},
+			expectedPath: "/status",
+			nodeResponses: map[string]string{
+				"first":  `{"result":{"sync_info":{"latest_block_height":"1010"}}}`,
+				"second": `{"result":{"sync_info":{"latest_block_height":"1008"}}}`,
+				"third":  `{"result":{"sync_info":{"latest_block_height":"1000"}}}`,
+			},
+			expectedTimeout: map[string]qos_models.TimeoutReason{"third": qos_models.OutOfSyncTimeout},
+		},
+		{
+			name: "majority",
+			chainCheck: &chain_checks_registry.ChainCheck{
+				ID:         "majority",
+				Method:     "GET",
+				Path:       "/block/{{height}}",
+				JsonPath:   mustParseJsonPath("$.hash"),
+				Comparison: chain_checks_registry.ComparisonMajority,
+			},
+			expectedPath: "/block/990",
+			nodeResponses: map[string]string{
+				"first":  `{"hash":"0xa"}`,
+				"second": `{"hash":"0xa"}`,
+				"third":  `{"hash":"0xb"}`,
+			},
+			expectedTimeout: map[string]qos_models.TimeoutReason{"third": qos_models.DataIntegrityTimeout},
+		},
+		{
+			name: "expected value",
+			chainCheck: &chain_checks_registry.ChainCheck{
+				ID:            "network",
+				Method:        "GET",
+				Path:          "/status",
+				JsonPath:      mustParseJsonPath("$.result.node_info.network"),
+				Comparison:    chain_checks_registry.ComparisonExpectedValue,
+				ExpectedValue: "cosmoshub-4",
+			},
+			expectedPath: "/status",
+			nodeResponses: map[string]string{
+				"first":  `{"result":{"node_info":{"network":"cosmoshub-4"}}}`,
+				"second": `{"result":{"node_info":{"network":"theta-testnet-001"}}}`,
+				"third":  `{"result":{}}`,
+			},
+			expectedTimeout: map[string]qos_models.TimeoutReason{"second": qos_models.UnexpectedValueTimeout, "third": qos_models.NodeResponseTimeout},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.chainCheck.ChainID = "0027"
+			tt.chainCheck.Interval = time.Minute
+			tt.chainCheck.Penalty = time.Minute * 10
+			lookbackHeight := int32(10)
+
+			pocketService := new(pocket_service_mock.PocketService)
+			pocketService.EXPECT().SendRelay(mock.Anything).RunAndReturn(func(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
+				assert.Equal(t, tt.chainCheck.Method, req.Payload.Method)
+				assert.Equal(t, tt.expectedPath, req.Payload.Path)
+				return &models.SendRelayResponse{Response: tt.nodeResponses[req.SelectedNodePubKey]}, nil
+			})
+			chainConfiguration := new(chain_configurations_registry_mock.ChainConfigurationsService)
+			chainConfiguration.EXPECT().GetChainConfiguration("0027").Return(db_query.GetChainConfigurationsRow{DataIntegrityCheckLookbackHeight: &lookbackHeight}, true).Maybe()
+			chainChecks := new(chain_checks_registry_mock.ChainChecksService)
+			chainChecks.EXPECT().GetChainChecks("0027").Return([]*chain_checks_registry.ChainCheck{tt.chainCheck})
+
+			nodes := []*qos_models.QosNode{
+				qos_models.NewTestQosNode("first", "0027", qos_models.WithSynced(), qos_models.WithLastKnownHeight(1000)),
+				qos_models.NewTestQosNode("second", "0027", qos_models.WithSynced(), qos_models.WithLastKnownHeight(1000)),
+				qos_models.NewTestQosNode("third", "0027", qos_models.WithSynced(), qos_models.WithLastKnownHeight(1000)),
+			}
+			check := NewCustomCheck(checks.NewCheck(pocketService, chainConfiguration), chainChecks, zap.NewNop())
+			check.SetNodes(nodes)
+			check.Perform()
+
+			for _, node := range nodes {
+				expectedTimeout, ok := tt.expectedTimeout[node.MorseNode.PublicKey]
+				assert.Equal(t, ok, node.IsInTimeout(), node.MorseNode.PublicKey)
+				if ok {
+					assert.Equal(t, expectedTimeout, node.GetTimeoutReason(), node.MorseNode.PublicKey)
+				}
+			}
+
+			// Nodes are not checked again until the check's interval passed
+			check.nextCheckTime = time.Time{}
+			check.Perform()
+			pocketService.AssertNumberOfCalls(t, "SendRelay", 3)
+		})
+	}
+}
+
+func mustParseJsonPath(path string) common.JsonPath {
+	jsonPath, err := common.ParseJsonPath(path)
+	if err != nil {
+		panic(err)
+	}
+	return jsonPath
+}
@@ -27,12 +27,19 @@ type nodeHashRspPair struct {
 
 type BlockHashParser func(response string) (string, error)
 
-type GetBlockByNumberPayloadFmter func(blockToFind uint64) string
+// BlockRequest - the relay sent to nodes to retrieve a block
+type BlockRequest struct {
+	Method  string
+	Payload string
+	Path    string
+}
+
+type GetBlockByNumberRequestFmter func(blockToFind uint64) BlockRequest
 
 // PerformDataIntegrityCheck: is the default implementation of a data integrity check by:
-func PerformDataIntegrityCheck(check *Check, calculatePayload GetBlockByNumberPayloadFmter, path string, retrieveBlockIdentifier BlockHashParser, logger *zap.Logger) {
+func PerformDataIntegrityCheck(check *Check, calculateRequest GetBlockByNumberRequestFmter, retrieveBlockIdentifier BlockHashParser, logger *zap.Logger) {
 	// Find a node that has been reported as healthy to use as source of truth
-	sourceOfTruth := findRandomHealthyNode(check.NodeList)
+	sourceOfTruth := FindRandomHealthyNode(check.NodeList)
 
 	// Node that is synced cannot be found, so we cannot run data integrity checks since we need a trusted source
 	if sourceOfTruth == nil {
@@ -50,7 +57,8 @@ func PerformDataIntegrityCheck(check *Check, calculatePayload GetBlockByNumberPa
 	// find a random block to search that nodes should have access too
 	blockNumberToSearch := sourceOfTruth.GetLastKnownHeight() - uint64(GetDataIntegrityHeightLookback(check.ChainConfiguration, sourceOfTruth.GetChain(), dataIntegrityHeightLookbackDefault))
 
-	attestationResponses := SendRelaysAsync(check.PocketRelayer, getEligibleDataIntegrityCheckNodes(check.NodeList), calculatePayload(blockNumberToSearch), "POST", path)
+	blockRequest := calculateRequest(blockNumberToSearch)
+	attestationResponses := SendRelaysAsync(check.PocketRelayer, getEligibleDataIntegrityCheckNodes(check.NodeList), blockRequest.Payload, blockRequest.Method, blockRequest.Path)
 	for rsp := range attestationResponses {
 
 		if rsp.Error != nil {
@@ -73,7 +81,7 @@ func PerformDataIntegrityCheck(check *Check, calculatePayload GetBlockByNumberPa
 		nodeResponseCounts[blockIdentifier]++
 	}
 
-	majorityBlockIdentifier := findMajorityBlockIdentifier(nodeResponseCounts)
+	majorityBlockIdentifier := FindMajorityBlockIdentifier(nodeResponseCounts)
 
 	// Blcok blockIdentifier must not be empty
 	if majorityBlockIdentifier == "" {
@@ -83,15 +91,20 @@ func PerformDataIntegrityCheck(check *Check, calculatePayload GetBlockByNumberPa
 	// Penalize other node operators with a timeout if they don't attest with same block blockIdentifier.
 	for _, nodeResp := range nodeResponsePairs {
 		if nodeResp.blockIdentifier != majorityBlockIdentifier {
-			logger.Sugar().Errorw("punishing node for failed data integrity check", "node", nodeResp.node.MorseNode.ServiceUrl, "nodeBlockHash", nodeResp.blockIdentifier, "trustedSourceBlockHash", majorityBlockIdentifier)
-			nodeResp.node.SetTimeoutUntil(time.Now().Add(dataIntegrityTimePenalty), models.DataIntegrityTimeout, fmt.Errorf("nodeBlockHash %s, trustedSourceBlockHash %s", nodeResp.blockIdentifier, majorityBlockIdentifier))
+			PunishDataIntegrityNode(nodeResp.node, fmt.Errorf("nodeBlockHash %s, trustedSourceBlockHash %s", nodeResp.blockIdentifier, majorityBlockIdentifier), logger)
 		}
 	}
 
 }
 
-// findRandomHealthyNode - returns a healthy node that is synced so we can use it as a source of truth for data integrity checks
-func findRandomHealthyNode(nodes []*models.QosNode) *models.QosNode {
+// PunishDataIntegrityNode - times out a node whose response does not match the response of a trusted source
+func PunishDataIntegrityNode(node *models.QosNode, err error, logger *zap.Logger) {
+	logger.Sugar().Errorw("punishing node for failed data integrity check", "node", node.MorseNode.ServiceUrl, "err", err)
+	node.SetTimeoutUntil(time.Now().Add(dataIntegrityTimePenalty), models.DataIntegrityTimeout, err)
+}
+
+// FindRandomHealthyNode - returns a healthy node that is synced so we can use it as a source of truth for data integrity checks
+func FindRandomHealthyNode(nodes []*models.QosNode) *models.QosNode {
 	var healthyNodes []*models.QosNode
 	for _, node := range nodes {
 		if node.IsHealthy() {
@@ -116,8 +129,8 @@ func getEligibleDataIntegrityCheckNodes(nodes []*models.QosNode) []*models.QosNo
 	return eligibleNodes
 }
 
-// findMajorityBlockIdentifier finds the blockIdentifier with the highest response count
-func findMajorityBlockIdentifier(responseCounts map[string]int) string {
+// FindMajorityBlockIdentifier finds the blockIdentifier with the highest response count
+func FindMajorityBlockIdentifier(responseCounts map[string]int) string {
 	var highestResponseIdentifier string
 	var highestResponseCount int
 	for rsp, count := range responseCounts {
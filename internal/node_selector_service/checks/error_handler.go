@@ -1,10 +1,12 @@
 package checks
 
 import (
+	"errors"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
 	relayer_models "github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
 	"github.com/valyala/fasthttp"
 	"go.uber.org/zap"
+	"net"
 	"strings"
 	"time"
 )
@@ -44,19 +46,29 @@ func isKickableSessionErr(err error) bool {
 	return doesErrorContains(errsKickSession, err)
 }
 
-func isTimeoutError(err error) bool {
+// IsTimeoutError - determines if a node did not answer in time or could not be reached, also used to answer relay callers with a timeout
+func IsTimeoutError(err error) bool {
 	// If Invalid block height, pocket  is not caught up to latest session
-	if err == relayer_models.ErrPocketCoreInvalidBlockHeight {
+	if errors.Is(err, relayer_models.ErrPocketCoreInvalidBlockHeight) {
 		return true
 	}
 
 	// Check if pocket error returns 500
-	pocketError, ok := err.(relayer_models.PocketRPCError)
-	if ok && pocketError.HttpCode >= 500 {
+	var pocketError relayer_models.PocketRPCError
+	if errors.As(err, &pocketError) && pocketError.HttpCode >= 500 {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
 		return true
 	}
 	// Fallback in the event the error is not parsed correctly due to node operator configurations / custom clients, resort to a simple string check
-	return err == fasthttp.ErrConnectionClosed || err == fasthttp.ErrTimeout || err == fasthttp.ErrDialTimeout || err == fasthttp.ErrTLSHandshakeTimeout || doesErrorContains(errsTimeout, err)
+	return errors.Is(err, fasthttp.ErrConnectionClosed) || errors.Is(err, fasthttp.ErrTimeout) || errors.Is(err, fasthttp.ErrDialTimeout) || errors.Is(err, fasthttp.ErrTLSHandshakeTimeout) || doesErrorContains(errsTimeout, err)
+}
+
+// IsNodeError - determines if an error is caused by the node and would be punished by DefaultPunishNode
+func IsNodeError(err error) bool {
+	return isKickableSessionErr(err) || IsTimeoutError(err)
 }
 
 // DefaultPunishNode generic punisher for whenever a node returns an error independent of a specific check
@@ -65,10 +77,16 @@ func DefaultPunishNode(err error, node *models.QosNode, logger *zap.Logger) bool
 		node.SetTimeoutUntil(time.Now().Add(kickOutSessionPenalty), models.MaximumRelaysTimeout, err)
 		return true
 	}
-	if isTimeoutError(err) {
+	if IsTimeoutError(err) {
 		node.SetTimeoutUntil(time.Now().Add(timeoutErrorPenalty), models.NodeResponseTimeout, err)
 		return true
 	}
 	logger.Sugar().Warnw("uncategorized error detected from pocket node", "node", node.MorseNode.ServiceUrl, "err", err)
 	return false
 }
+
+// PunishWrongChainNode - removes a node that serves a different chain than it is staked for from the rest of its session
+func PunishWrongChainNode(node *models.QosNode, err error, logger *zap.Logger) {
+	logger.Sugar().Errorw("punishing node for serving the wrong chain", "node", node.MorseNode.ServiceUrl, "chain", node.GetChain(), "err", err)
+	node.SetTimeoutUntil(time.Now().Add(kickOutSessionPenalty), models.WrongChainTimeout, err)
+}
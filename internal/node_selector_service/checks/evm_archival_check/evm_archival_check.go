@@ -0,0 +1,100 @@
+package evm_archival_check
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"go.uber.org/zap"
+	"strconv"
+	"time"
+)
+
+const (
+	// interval to look for nodes that have not been checked yet, a node is only checked once per session
+	evmArchivalCheckInterval = time.Second * 5
+
+	// default block to request historical state at, if the chain does not have one configured
+	defaultArchivalCheckBlockHeight = 1000000
+
+	// jsonrpc payload to retrieve the balance of the zero address at a historical block, which only archival nodes can serve
+	balancePayloadFmt = `{"jsonrpc":"2.0","method":"eth_getBalance","params":["0x0000000000000000000000000000000000000000","%s"],"id":1}`
+)
+
+type balanceResponse struct {
+	Result *string         `json:"result"`
+	Error  json.RawMessage `json:"error"`
+}
+
+type EvmArchivalCheck struct {
+	*checks.Check
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewEvmArchivalCheck(check *checks.Check, logger *zap.Logger) *EvmArchivalCheck {
+	return &EvmArchivalCheck{Check: check, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *EvmArchivalCheck) Name() string {
+	return "evm_archival_check"
+}
+
+func (c *EvmArchivalCheck) Perform() {
+
+	// Session is not meant for EVM
+	if len(c.NodeList) == 0 || !c.IsEvmChain(c.NodeList[0]) {
+		return
+	}
+	c.nextCheckTime = time.Now().Add(evmArchivalCheckInterval)
+
+	archivalBlockHeight := c.getArchivalCheckBlockHeight(c.NodeList[0].GetChain())
+	nodes := getUncheckedNodes(c.NodeList, archivalBlockHeight)
+	if len(nodes) == 0 {
+		return
+	}
+
+	for rsp := range checks.SendRelaysAsync(c.PocketRelayer, nodes, getBalancePayload(archivalBlockHeight), "POST", "") {
+		if rsp.Error != nil {
+			checks.DefaultPunishNode(rsp.Error, rsp.Node, c.logger)
+			continue
+		}
+		// Pruned nodes answer with an error such as "missing trie node"
+		var balanceRsp balanceResponse
+		archival := json.Unmarshal([]byte(rsp.Relay.Response), &balanceRsp) == nil && balanceRsp.Error == nil && balanceRsp.Result != nil
+		rsp.Node.SetArchival(archival)
+		rsp.Node.SetLastArchivalCheckTime(time.Now())
+	}
+}
+
+func (c *EvmArchivalCheck) getArchivalCheckBlockHeight(chainId string) uint64 {
+	chainConfig, ok := c.ChainConfiguration.GetChainConfiguration(chainId)
+	if !ok || chainConfig.ArchivalCheckBlockHeight == nil || *chainConfig.ArchivalCheckBlockHeight <= 0 {
+		return defaultArchivalCheckBlockHeight
+	}
+	return uint64(*chainConfig.ArchivalCheckBlockHeight)
+}
+
+func (c *EvmArchivalCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *EvmArchivalCheck) ShouldRun() bool {
+	return time.Now().After(c.nextCheckTime)
+}
+
+// getUncheckedNodes - returns the nodes that have not been checked yet. Nodes are only checked once their height is known
+// to be past the archival block, since any node fails to serve a block it has not synced yet.
+func getUncheckedNodes(nodes []*models.QosNode, archivalBlockHeight uint64) []*models.QosNode {
+	var uncheckedNodes []*models.QosNode
+	for _, node := range nodes {
+		if node.GetLastArchivalCheckTime().IsZero() && !node.IsInTimeout() && node.GetLastKnownHeight() > archivalBlockHeight {
+			uncheckedNodes = append(uncheckedNodes, node)
+		}
+	}
+	return uncheckedNodes
+}
+
+func getBalancePayload(blockNumber uint64) string {
+	return fmt.Sprintf(balancePayloadFmt, "0x"+strconv.FormatUint(blockNumber, 16))
+}
@@ -0,0 +1,46 @@
+package evm_archival_check
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	chain_configurations_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_configurations_registry"
+	pocket_service_mock "github.com/pokt-network/gateway-server/mocks/pocket_service"
+	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestEvmArchivalCheck(t *testing.T) {
+	archivalBlockHeight := int32(100)
+	// node public key > response to eth_getBalance at the archival block
+	nodeResponses := map[string]string{
+		"archival": `{"jsonrpc":"2.0","id":1,"result":"0x0"}`,
+		"pruned":   `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"missing trie node"}}`,
+	}
+
+	pocketService := new(pocket_service_mock.PocketService)
+	pocketService.EXPECT().SendRelay(mock.Anything).RunAndReturn(func(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
+		assert.Equal(t, getBalancePayload(100), req.Payload.Data)
+		return &models.SendRelayResponse{Response: nodeResponses[req.SelectedNodePubKey]}, nil
+	})
+	chainConfiguration := new(chain_configurations_registry_mock.ChainConfigurationsService)
+	chainConfiguration.EXPECT().GetChainConfiguration("0021").Return(db_query.GetChainConfigurationsRow{ChainFamily: pgtype.Varchar{String: "evm", Status: pgtype.Present}, ArchivalCheckBlockHeight: &archivalBlockHeight}, true)
+
+	// The behind node has not synced the archival block yet, so it is not checked
+	archivalNode := qos_models.NewTestQosNode("archival", "0021", qos_models.WithLastKnownHeight(1000))
+	prunedNode := qos_models.NewTestQosNode("pruned", "0021", qos_models.WithLastKnownHeight(1000))
+	behindNode := qos_models.NewTestQosNode("behind", "0021", qos_models.WithLastKnownHeight(50))
+	check := NewEvmArchivalCheck(checks.NewCheck(pocketService, chainConfiguration), zap.NewNop())
+	check.SetNodes([]*qos_models.QosNode{archivalNode, prunedNode, behindNode})
+	check.Perform()
+
+	assert.True(t, archivalNode.IsArchival())
+	assert.False(t, prunedNode.IsArchival())
+	assert.False(t, prunedNode.GetLastArchivalCheckTime().IsZero())
+	assert.True(t, behindNode.GetLastArchivalCheckTime().IsZero())
+	pocketService.AssertNumberOfCalls(t, "SendRelay", 2)
+}
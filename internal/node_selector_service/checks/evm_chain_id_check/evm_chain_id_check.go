@@ -0,0 +1,134 @@
+package evm_chain_id_check
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"go.uber.org/zap"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// interval to look for nodes whose chain id has not been checked yet, a node is only checked once per session
+	evmChainIdCheckInterval = time.Second * 5
+
+	// jsonrpc payload to retrieve the chain id
+	chainIdJsonPayload = `{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`
+)
+
+type chainIdResponse struct {
+	Result string          `json:"result"`
+	Error  json.RawMessage `json:"error"`
+}
+
+type EvmChainIdCheck struct {
+	*checks.Check
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewEvmChainIdCheck(check *checks.Check, logger *zap.Logger) *EvmChainIdCheck {
+	return &EvmChainIdCheck{Check: check, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *EvmChainIdCheck) Name() string {
+	return "evm_chain_id_check"
+}
+
+func (c *EvmChainIdCheck) Perform() {
+
+	// Session is not meant for EVM
+	if len(c.NodeList) == 0 || !c.IsEvmChain(c.NodeList[0]) {
+		return
+	}
+	c.nextCheckTime = time.Now().Add(evmChainIdCheckInterval)
+
+	chainId := c.NodeList[0].GetChain()
+	expectedChainId, ok := c.getExpectedChainId(chainId)
+	if !ok {
+		return
+	}
+	nodes := getUncheckedNodes(c.NodeList)
+	if len(nodes) == 0 {
+		return
+	}
+
+	for _, node := range c.checkChainIds(nodes, expectedChainId) {
+		// net_version answers with the network id, which differs from the chain id on some chains (e.g. Ethereum
+		// Classic), so nodes that do not support eth_chainId cannot be verified and are not checked again
+		c.logger.Sugar().Warnw("node does not support eth_chainId", "node", node.MorseNode.ServiceUrl, "chain", chainId)
+		node.SetLastChainIdCheckTime(time.Now())
+	}
+}
+
+// checkChainIds - sends eth_chainId to the nodes and punishes nodes that answer with a different chain id. Returns the
+// nodes that answered with a JSON-RPC error.
+func (c *EvmChainIdCheck) checkChainIds(nodes []*models.QosNode, expectedChainId uint64) []*models.QosNode {
+	var unsupportedNodes []*models.QosNode
+	for rsp := range checks.SendRelaysAsync(c.PocketRelayer, nodes, chainIdJsonPayload, "POST", "") {
+		if rsp.Error != nil {
+			checks.DefaultPunishNode(rsp.Error, rsp.Node, c.logger)
+			continue
+		}
+		var chainIdRsp chainIdResponse
+		if err := json.Unmarshal([]byte(rsp.Relay.Response), &chainIdRsp); err != nil || chainIdRsp.Error != nil {
+			unsupportedNodes = append(unsupportedNodes, rsp.Node)
+			continue
+		}
+		nodeChainId, err := ParseChainId(chainIdRsp.Result)
+		if err != nil {
+			unsupportedNodes = append(unsupportedNodes, rsp.Node)
+			continue
+		}
+		rsp.Node.SetLastChainIdCheckTime(time.Now())
+		if nodeChainId != expectedChainId {
+			checks.PunishWrongChainNode(rsp.Node, fmt.Errorf("node chain id %d, expected chain id %d", nodeChainId, expectedChainId), c.logger)
+		}
+	}
+	return unsupportedNodes
+}
+
+// getExpectedChainId - returns the EVM chain id configured for the chain, the check is skipped for chains without one.
+func (c *EvmChainIdCheck) getExpectedChainId(chainId string) (uint64, bool) {
+	chainConfig, ok := c.ChainConfiguration.GetChainConfiguration(chainId)
+	if !ok || chainConfig.EvmChainID.String == "" {
+		return 0, false
+	}
+	expectedChainId, err := ParseChainId(chainConfig.EvmChainID.String)
+	if err != nil {
+		c.logger.Sugar().Warnw("invalid evm chain id", "chain", chainId, "evmChainId", chainConfig.EvmChainID.String)
+		return 0, false
+	}
+	return expectedChainId, true
+}
+
+func (c *EvmChainIdCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *EvmChainIdCheck) ShouldRun() bool {
+	return time.Now().After(c.nextCheckTime)
+}
+
+// getUncheckedNodes - returns the nodes whose chain id has not been checked, skipping nodes in a timeout since they
+// would not answer anyway.
+func getUncheckedNodes(nodes []*models.QosNode) []*models.QosNode {
+	var uncheckedNodes []*models.QosNode
+	for _, node := range nodes {
+		if node.GetLastChainIdCheckTime().IsZero() && !node.IsInTimeout() {
+			uncheckedNodes = append(uncheckedNodes, node)
+		}
+	}
+	return uncheckedNodes
+}
+
+// ParseChainId - parses a chain id as a hex string (eth_chainId) or as a decimal string.
+func ParseChainId(chainId string) (uint64, error) {
+	if hexChainId, ok := strings.CutPrefix(chainId, "0x"); ok {
+		return strconv.ParseUint(hexChainId, 16, 64)
+	}
+	return strconv.ParseUint(chainId, 10, 64)
+}
@@ -0,0 +1,72 @@
+package evm_chain_id_check
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	chain_configurations_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_configurations_registry"
+	pocket_service_mock "github.com/pokt-network/gateway-server/mocks/pocket_service"
+	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestEvmChainIdCheck(t *testing.T) {
+	// node public key > response to eth_chainId
+	nodeResponses := map[string]string{
+		"classic":  `{"jsonrpc":"2.0","id":1,"result":"0x3d"}`,
+		"ethereum": `{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+		// Nodes without eth_chainId are not verified, net_version would answer Ethereum Classic's network id 1
+		"legacy": `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`,
+	}
+	evmChainId := pgtype.Varchar{}
+	evmChainId.Set("61")
+
+	pocketService := new(pocket_service_mock.PocketService)
+	pocketService.EXPECT().SendRelay(mock.Anything).RunAndReturn(func(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
+		return &models.SendRelayResponse{Response: nodeResponses[req.SelectedNodePubKey]}, nil
+	})
+	chainConfiguration := new(chain_configurations_registry_mock.ChainConfigurationsService)
+	chainConfiguration.EXPECT().GetChainConfiguration("0021").Return(db_query.GetChainConfigurationsRow{ChainFamily: pgtype.Varchar{String: "evm", Status: pgtype.Present}, EvmChainID: evmChainId}, true)
+
+	classicNode, ethereumNode, legacyNode := qos_models.NewTestQosNode("classic", "0021"), qos_models.NewTestQosNode("ethereum", "0021"), qos_models.NewTestQosNode("legacy", "0021")
+	check := NewEvmChainIdCheck(checks.NewCheck(pocketService, chainConfiguration), zap.NewNop())
+	check.SetNodes([]*qos_models.QosNode{classicNode, ethereumNode, legacyNode})
+	check.Perform()
+
+	assert.False(t, classicNode.IsInTimeout())
+	assert.False(t, legacyNode.IsInTimeout())
+	assert.True(t, ethereumNode.IsInTimeout())
+	assert.Equal(t, qos_models.WrongChainTimeout, ethereumNode.GetTimeoutReason())
+	for _, node := range []*qos_models.QosNode{classicNode, ethereumNode, legacyNode} {
+		assert.False(t, node.GetLastChainIdCheckTime().IsZero())
+	}
+
+	// Nodes are only checked once per session
+	check.nextCheckTime = check.nextCheckTime.AddDate(0, 0, -1)
+	check.Perform()
+	pocketService.AssertNumberOfCalls(t, "SendRelay", 3)
+}
+
+func TestParseChainId(t *testing.T) {
+	tests := []struct {
+		chainId  string
+		expected uint64
+		wantErr  bool
+	}{
+		{chainId: "0x89", expected: 137},
+		{chainId: "137", expected: 137},
+		{chainId: "0xzz", wantErr: true},
+		{chainId: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.chainId, func(t *testing.T) {
+			chainId, err := ParseChainId(tt.chainId)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, chainId)
+		})
+	}
+}
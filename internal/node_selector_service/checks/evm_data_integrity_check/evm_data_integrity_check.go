@@ -57,7 +57,7 @@ func (c *EvmDataIntegrityCheck) Perform() {
 	if len(c.NodeList) == 0 || !c.IsEvmChain(c.NodeList[0]) {
 		return
 	}
-	checks.PerformDataIntegrityCheck(c.Check, getBlockByNumberPayload, "", c.getBlockHashFromNodeResponse, c.logger)
+	checks.PerformDataIntegrityCheck(c.Check, getBlockByNumberRequest, c.getBlockHashFromNodeResponse, c.logger)
 	c.nextCheckTime = time.Now().Add(dataIntegrityCheckInterval)
 }
 
@@ -65,6 +65,6 @@ func (c *EvmDataIntegrityCheck) ShouldRun() bool {
 	return c.nextCheckTime.IsZero() || time.Now().After(c.nextCheckTime)
 }
 
-func getBlockByNumberPayload(blockNumber uint64) string {
-	return fmt.Sprintf(blockPayloadFmt, "0x"+strconv.FormatInt(int64(blockNumber), 16))
+func getBlockByNumberRequest(blockNumber uint64) checks.BlockRequest {
+	return checks.BlockRequest{Method: "POST", Payload: fmt.Sprintf(blockPayloadFmt, "0x"+strconv.FormatInt(int64(blockNumber), 16))}
 }
@@ -18,7 +18,7 @@ const (
 	evmHeightCheckInterval = time.Second * 1
 
 	// jsonrpc payload to retrieve evm height
-	heightJsonPayload = `{"jsonrpc":"2.0","method":"eth_blockNumber","params": [],"id":1}`
+	HeightJsonPayload = `{"jsonrpc":"2.0","method":"eth_blockNumber","params": [],"id":1}`
 )
 
 type evmHeightResponse struct {
@@ -70,7 +70,7 @@ func (c *EvmHeightCheck) Perform() {
 	if len(c.NodeList) == 0 || !c.IsEvmChain(c.NodeList[0]) {
 		return
 	}
-	checks.PerformDefaultHeightCheck(c.Check, heightJsonPayload, "", c.getHeightFromNodeResponse, c.logger)
+	checks.PerformDefaultHeightCheck(c.Check, "POST", HeightJsonPayload, "", ParseHeight, c.logger)
 	c.nextCheckTime = time.Now().Add(evmHeightCheckInterval)
 }
 
@@ -82,7 +82,8 @@ func (c *EvmHeightCheck) ShouldRun() bool {
 	return time.Now().After(c.nextCheckTime)
 }
 
-func (c *EvmHeightCheck) getHeightFromNodeResponse(response string) (uint64, error) {
+// ParseHeight - parses the height from the response to HeightJsonPayload, also used to probe altruists.
+func ParseHeight(response string) (uint64, error) {
 	var evmRsp evmHeightResponse
 	err := json.Unmarshal([]byte(response), &evmRsp)
 	if err != nil {
@@ -21,17 +21,17 @@ type HeightJsonParser func(response string) (uint64, error)
 
 // PerformDefaultHeightCheck is the default implementation of a height check by:
 // 0. Filtering out nodes that have not been checked since defaultNodeHeightCheckInterval
-// 1. Sending height request via payload to all the nodes
+// 1. Sending height request via method, payload and path to all the nodes
 // 2. Punishing all nodes that return an error
 // 3. Filtering out nodes that are returning a height out of the zScore threshold
 // 4. Punishing the nodes with defaultCheckPenalty that exceed the height tolerance.
-func PerformDefaultHeightCheck(check *Check, payload string, path string, parseHeight HeightJsonParser, logger *zap.Logger) {
+func PerformDefaultHeightCheck(check *Check, method string, payload string, path string, parseHeight HeightJsonParser, logger *zap.Logger) {
 
 	logger.Sugar().Infow("running default height check", "chain", check.NodeList[0].GetChain())
 
 	var nodesResponded []*models.QosNode
 	// Send request to all nodes
-	relayResponses := SendRelaysAsync(check.PocketRelayer, getEligibleHeightCheckNodes(check.NodeList), payload, "POST", path)
+	relayResponses := SendRelaysAsync(check.PocketRelayer, getEligibleHeightCheckNodes(check.NodeList), payload, method, path)
 
 	// Process relay responses
 	for resp := range relayResponses {
@@ -54,7 +54,7 @@ func PerformDefaultHeightCheck(check *Check, payload string, path string, parseH
 		nodesResponded = append(nodesResponded, resp.Node)
 	}
 
-	highestNodeHeight := getHighestNodeHeight(nodesResponded, defaultZScoreHeightThreshold)
+	highestNodeHeight := GetHighestNodeHeight(nodesResponded)
 	// Compare each node's reported height against the highest reported height
 	for _, node := range nodesResponded {
 		heightDifference := int(highestNodeHeight - node.GetLastKnownHeight())
@@ -70,6 +70,11 @@ func PerformDefaultHeightCheck(check *Check, payload string, path string, parseH
 	}
 }
 
+// GetHighestNodeHeight returns the highest height reported from a slice of nodes, ignoring outliers
+func GetHighestNodeHeight(nodes []*models.QosNode) uint64 {
+	return getHighestNodeHeight(nodes, defaultZScoreHeightThreshold)
+}
+
 // getHighestHeight returns the highest height reported from a slice of nodes
 // by using z-score threshhold to prevent any misconfigured or malicious node
 func getHighestNodeHeight(nodes []*models.QosNode, zScoreHeightThreshhold float64) uint64 {
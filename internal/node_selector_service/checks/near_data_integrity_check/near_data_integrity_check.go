@@ -0,0 +1,87 @@
+package near_data_integrity_check
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	// how often the job should run
+	dataIntegrityCheckInterval = time.Second * 1
+
+	//json rpc payload to send a data integrity check
+	blockPayloadFmt = `{"jsonrpc":"2.0","method":"block","params":{"block_id":%d},"id":1}`
+
+	// NEAR skips heights whose block producer missed its slot, every synced node answers these with an UNKNOWN_BLOCK error
+	unknownBlockError = "UNKNOWN_BLOCK"
+)
+
+type blockResponse struct {
+	Result *struct {
+		Header struct {
+			Hash string `json:"hash"`
+		} `json:"header"`
+	} `json:"result"`
+	Error *struct {
+		Cause struct {
+			Name string `json:"name"`
+		} `json:"cause"`
+	} `json:"error"`
+}
+
+type NearDataIntegrityCheck struct {
+	*checks.Check
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewNearDataIntegrityCheck(check *checks.Check, logger *zap.Logger) *NearDataIntegrityCheck {
+	return &NearDataIntegrityCheck{Check: check, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *NearDataIntegrityCheck) Name() string {
+	return "near_data_integrity_check"
+}
+
+func (c *NearDataIntegrityCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *NearDataIntegrityCheck) Perform() {
+
+	// Session is not meant for NEAR
+	if len(c.NodeList) == 0 || c.GetChainFamily(c.NodeList[0]) != checks.ChainFamilyNear {
+		return
+	}
+	checks.PerformDataIntegrityCheck(c.Check, getBlockByNumberRequest, getBlockHashFromNodeResponse, c.logger)
+	c.nextCheckTime = time.Now().Add(dataIntegrityCheckInterval)
+}
+
+func (c *NearDataIntegrityCheck) ShouldRun() bool {
+	return c.nextCheckTime.IsZero() || time.Now().After(c.nextCheckTime)
+}
+
+func getBlockByNumberRequest(blockNumber uint64) checks.BlockRequest {
+	return checks.BlockRequest{Method: "POST", Payload: fmt.Sprintf(blockPayloadFmt, blockNumber)}
+}
+
+// getBlockHashFromNodeResponse - returns the hash of the block. Skipped heights are identified by their error, so that
+// nodes agreeing on a skipped height are not punished.
+func getBlockHashFromNodeResponse(response string) (string, error) {
+	var blockRsp blockResponse
+	if err := json.Unmarshal([]byte(response), &blockRsp); err != nil {
+		return "", err
+	}
+	if blockRsp.Error != nil && blockRsp.Error.Cause.Name == unknownBlockError {
+		return unknownBlockError, nil
+	}
+	if blockRsp.Result == nil || blockRsp.Result.Header.Hash == "" {
+		return "", errors.New("missing block hash")
+	}
+	return blockRsp.Result.Header.Hash, nil
+}
@@ -0,0 +1,38 @@
+package near_data_integrity_check
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetBlockHashFromNodeResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "block",
+			response: `{"jsonrpc":"2.0","result":{"author":"node1","chunks":[],"header":{"height":118713185,"hash":"5Fe5V2pWPBTxD9aHxqMt9hK8G5PBRJ3N5eQtN1tqoGnp","prev_hash":"9zkq6HGXHrSVDnFXH7Xqq2qgHvWVLUNHkFDpsfzNgYHT"}},"id":1}`,
+			expected: "5Fe5V2pWPBTxD9aHxqMt9hK8G5PBRJ3N5eQtN1tqoGnp",
+		},
+		{
+			name:     "skipped height",
+			response: `{"jsonrpc":"2.0","error":{"name":"HANDLER_ERROR","cause":{"info":{},"name":"UNKNOWN_BLOCK"},"code":-32000,"message":"Server error","data":"DB Not Found Error: BLOCK HEIGHT: 118713185"},"id":1}`,
+			expected: "UNKNOWN_BLOCK",
+		},
+		{
+			name:     "garbage collected block",
+			response: `{"jsonrpc":"2.0","error":{"name":"HANDLER_ERROR","cause":{"info":{},"name":"GARBAGE_COLLECTED_BLOCK"},"code":-32000,"message":"Server error"},"id":1}`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := getBlockHashFromNodeResponse(tt.response)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, hash)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package near_height_check
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+
+	// interval to run the near height check
+	nearHeightCheckInterval = time.Second * 1
+
+	// jsonrpc payload to retrieve the near node status
+	HeightJsonPayload = `{"jsonrpc":"2.0","method":"status","params":[],"id":1}`
+)
+
+type statusResponse struct {
+	Result *struct {
+		SyncInfo struct {
+			LatestBlockHeight uint64 `json:"latest_block_height"`
+		} `json:"sync_info"`
+	} `json:"result"`
+}
+
+type NearHeightCheck struct {
+	*checks.Check
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewNearHeightCheck(check *checks.Check, logger *zap.Logger) *NearHeightCheck {
+	return &NearHeightCheck{Check: check, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *NearHeightCheck) Name() string {
+	return "near_height_check"
+}
+
+func (c *NearHeightCheck) Perform() {
+
+	// Session is not meant for NEAR
+	if len(c.NodeList) == 0 || c.GetChainFamily(c.NodeList[0]) != checks.ChainFamilyNear {
+		return
+	}
+	checks.PerformDefaultHeightCheck(c.Check, "POST", HeightJsonPayload, "", ParseHeight, c.logger)
+	c.nextCheckTime = time.Now().Add(nearHeightCheckInterval)
+}
+
+func (c *NearHeightCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *NearHeightCheck) ShouldRun() bool {
+	return time.Now().After(c.nextCheckTime)
+}
+
+// ParseHeight - parses the height from the response to HeightJsonPayload, also used to probe altruists.
+func ParseHeight(response string) (uint64, error) {
+	var statusRsp statusResponse
+	if err := json.Unmarshal([]byte(response), &statusRsp); err != nil {
+		return 0, err
+	}
+	if statusRsp.Result == nil {
+		return 0, errors.New("missing status result")
+	}
+	return statusRsp.Result.SyncInfo.LatestBlockHeight, nil
+}
@@ -0,0 +1,38 @@
+package near_height_check
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseHeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected uint64
+		wantErr  bool
+	}{
+		{
+			name:     "status",
+			response: `{"jsonrpc":"2.0","result":{"chain_id":"mainnet","sync_info":{"latest_block_hash":"8pmZ8BuW9e9XW5fhT3GJvqdqLGG9ZTY3L7Jfrp5xzQHG","latest_block_height":118713210,"syncing":false},"version":{"version":"1.39.1"}},"id":1}`,
+			expected: 118713210,
+		},
+		{
+			name:     "error",
+			response: `{"jsonrpc":"2.0","error":{"name":"INTERNAL_ERROR","cause":{"name":"INTERNAL_ERROR"},"code":-32000,"message":"Server error"},"id":1}`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid json",
+			response: `<html>Bad Gateway</html>`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			height, err := ParseHeight(tt.response)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, height)
+		})
+	}
+}
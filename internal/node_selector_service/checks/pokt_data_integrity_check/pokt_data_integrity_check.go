@@ -58,7 +58,7 @@ func (c *PoktDataIntegrityCheck) Perform() {
 	if len(c.NodeList) == 0 || !c.IsPoktChain(c.NodeList[0]) {
 		return
 	}
-	checks.PerformDataIntegrityCheck(c.Check, getBlockByNumberPayload, poktBlockTxEndpoint, c.getBlockIdentifierFromNodeResponse, c.logger)
+	checks.PerformDataIntegrityCheck(c.Check, getBlockByNumberRequest, c.getBlockIdentifierFromNodeResponse, c.logger)
 	c.nextCheckTime = time.Now().Add(dataIntegrityCheckInterval)
 }
 
@@ -66,6 +66,6 @@ func (c *PoktDataIntegrityCheck) ShouldRun() bool {
 	return c.nextCheckTime.IsZero() || time.Now().After(c.nextCheckTime)
 }
 
-func getBlockByNumberPayload(blockNumber uint64) string {
-	return fmt.Sprintf(blockPayloadFmt, blockNumber)
+func getBlockByNumberRequest(blockNumber uint64) checks.BlockRequest {
+	return checks.BlockRequest{Method: "POST", Payload: fmt.Sprintf(blockPayloadFmt, blockNumber), Path: poktBlockTxEndpoint}
 }
@@ -14,7 +14,10 @@ const (
 	poktHeightCheckInterval = time.Second * 1
 
 	// jsonrpc payload to pokt evm height
-	heightJsonPayload = ``
+	HeightJsonPayload = ``
+
+	// path of the pokt height request
+	HeightPath = "/v1/query/height"
 )
 
 type poktHeightResponse struct {
@@ -41,7 +44,7 @@ func (c *PoktHeightCheck) Perform() {
 	if len(c.NodeList) == 0 || !c.IsPoktChain(c.NodeList[0]) {
 		return
 	}
-	checks.PerformDefaultHeightCheck(c.Check, heightJsonPayload, "/v1/query/height", c.getHeightFromNodeResponse, c.logger)
+	checks.PerformDefaultHeightCheck(c.Check, "POST", HeightJsonPayload, HeightPath, ParseHeight, c.logger)
 	c.nextCheckTime = time.Now().Add(poktHeightCheckInterval)
 }
 
@@ -53,7 +56,8 @@ func (c *PoktHeightCheck) ShouldRun() bool {
 	return time.Now().After(c.nextCheckTime)
 }
 
-func (c *PoktHeightCheck) getHeightFromNodeResponse(response string) (uint64, error) {
+// ParseHeight - parses the height from the response to HeightJsonPayload, also used to probe altruists.
+func ParseHeight(response string) (uint64, error) {
 	var poktRsp poktHeightResponse
 	err := json.Unmarshal([]byte(response), &poktRsp)
 	if err != nil {
@@ -2,21 +2,27 @@ package checks
 
 import (
 	"github.com/pokt-network/gateway-server/internal/chain_configurations_registry"
-	"github.com/pokt-network/gateway-server/internal/chain_network"
-	config2 "github.com/pokt-network/gateway-server/internal/global_config"
 	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
 	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0"
 )
 
-const (
-	chainMorseMainnetSolanaCustom = "C006"
-	chainMorseMainnetPokt         = "0001"
-	chainMorseMainnetSolana       = "0006"
-)
+// ChainFamily - the API served by the nodes of a chain, configured per chain through chain_family
+type ChainFamily string
 
 const (
-	chainMorseTestnetPokt   = "0013"
-	chainMorseTestnetSolana = "0008"
+	ChainFamilyEvm    ChainFamily = "evm"
+	ChainFamilySolana ChainFamily = "solana"
+	ChainFamilyPokt   ChainFamily = "pokt"
+	// Cosmos SDK chains whose nodes serve the Tendermint RPC
+	ChainFamilyCosmos ChainFamily = "cosmos"
+	// Cosmos SDK chains whose nodes serve the LCD REST API
+	ChainFamilyCosmosLcd ChainFamily = "cosmos_lcd"
+	ChainFamilyNear      ChainFamily = "near"
+	// Bitcoin and the UTXO chains that forked its RPC, such as Litecoin and Dogecoin
+	ChainFamilyBitcoin  ChainFamily = "bitcoin"
+	ChainFamilyStarknet ChainFamily = "starknet"
+	// Chains that no built-in check supports, only the chain's custom checks run against its nodes
+	ChainFamilyNone ChainFamily = "none"
 )
 
 type CheckJob interface {
@@ -27,32 +33,51 @@ type CheckJob interface {
 }
 
 type Check struct {
-	NodeList             []*qos_models.QosNode
-	PocketRelayer        pokt_v0.PocketRelayer
-	ChainConfiguration   chain_configurations_registry.ChainConfigurationsService
-	ChainNetworkProvider config2.ChainNetworkProvider
+	NodeList           []*qos_models.QosNode
+	PocketRelayer      pokt_v0.PocketRelayer
+	ChainConfiguration chain_configurations_registry.ChainConfigurationsService
+}
+
+func NewCheck(pocketRelayer pokt_v0.PocketRelayer, chainConfiguration chain_configurations_registry.ChainConfigurationsService) *Check {
+	return &Check{PocketRelayer: pocketRelayer, ChainConfiguration: chainConfiguration}
 }
 
-func NewCheck(pocketRelayer pokt_v0.PocketRelayer, chainConfiguration chain_configurations_registry.ChainConfigurationsService, chainNetworkProvider config2.ChainNetworkProvider) *Check {
-	return &Check{PocketRelayer: pocketRelayer, ChainConfiguration: chainConfiguration, ChainNetworkProvider: chainNetworkProvider}
+// GetChainFamily - returns the family of the node's chain, which decides the checks that run against the node.
+func (c *Check) GetChainFamily(node *qos_models.QosNode) ChainFamily {
+	return GetChainFamily(c.ChainConfiguration, node.GetChain())
 }
 
 func (c *Check) IsSolanaChain(node *qos_models.QosNode) bool {
-	chainId := node.GetChain()
-	if c.ChainNetworkProvider.GetChainNetwork() == chain_network.MorseTestnet {
-		return chainId == chainMorseTestnetSolana
-	}
-	return chainId == chainMorseMainnetSolana || chainId == chainMorseMainnetSolanaCustom
+	return c.GetChainFamily(node) == ChainFamilySolana
 }
 
 func (c *Check) IsPoktChain(node *qos_models.QosNode) bool {
-	chainId := node.GetChain()
-	if c.ChainNetworkProvider.GetChainNetwork() == chain_network.MorseTestnet {
-		return chainId == chainMorseTestnetPokt
-	}
-	return chainId == chainMorseMainnetPokt
+	return c.GetChainFamily(node) == ChainFamilyPokt
 }
 
 func (c *Check) IsEvmChain(node *qos_models.QosNode) bool {
-	return !c.IsPoktChain(node) && !c.IsSolanaChain(node)
+	return c.GetChainFamily(node) == ChainFamilyEvm
+}
+
+// GetChainFamily - returns the chain family configured for the chain. The API of chains without a configured family
+// is unknown, so they are none and the built-in checks do not punish their nodes.
+func GetChainFamily(chainConfiguration chain_configurations_registry.ChainConfigurationsService, chainId string) ChainFamily {
+	if chainConfig, ok := chainConfiguration.GetChainConfiguration(chainId); ok && IsKnownChainFamily(chainConfig.ChainFamily.String) {
+		return ChainFamily(chainConfig.ChainFamily.String)
+	}
+	return ChainFamilyNone
+}
+
+// IsEvmChainId - returns whether the chain is EVM, used outside of checks that run against session nodes.
+func IsEvmChainId(chainConfiguration chain_configurations_registry.ChainConfigurationsService, chainId string) bool {
+	return GetChainFamily(chainConfiguration, chainId) == ChainFamilyEvm
+}
+
+// IsKnownChainFamily - returns whether the family is supported by the checks.
+func IsKnownChainFamily(family string) bool {
+	switch ChainFamily(family) {
+	case ChainFamilyEvm, ChainFamilySolana, ChainFamilyPokt, ChainFamilyCosmos, ChainFamilyCosmosLcd, ChainFamilyNear, ChainFamilyBitcoin, ChainFamilyStarknet, ChainFamilyNone:
+		return true
+	}
+	return false
 }
@@ -57,7 +57,7 @@ func (c *SolanaDataIntegrityCheck) Perform() {
 	if len(c.NodeList) == 0 || !c.IsSolanaChain(c.NodeList[0]) {
 		return
 	}
-	checks.PerformDataIntegrityCheck(c.Check, getBlockByNumberPayload, "", c.getBlockIdentifierFromNodeResponse, c.logger)
+	checks.PerformDataIntegrityCheck(c.Check, getBlockByNumberRequest, c.getBlockIdentifierFromNodeResponse, c.logger)
 	c.nextCheckTime = time.Now().Add(dataIntegrityCheckInterval)
 }
 
@@ -65,6 +65,6 @@ func (c *SolanaDataIntegrityCheck) ShouldRun() bool {
 	return c.nextCheckTime.IsZero() || time.Now().After(c.nextCheckTime)
 }
 
-func getBlockByNumberPayload(blockNumber uint64) string {
-	return fmt.Sprintf(blockPayloadFmt, blockNumber)
+func getBlockByNumberRequest(blockNumber uint64) checks.BlockRequest {
+	return checks.BlockRequest{Method: "POST", Payload: fmt.Sprintf(blockPayloadFmt, blockNumber)}
 }
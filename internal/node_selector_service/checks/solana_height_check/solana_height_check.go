@@ -14,7 +14,7 @@ const (
 	solanaHeightCheckInterval = time.Second * 1
 
 	// jsonrpc payload to retrieve solana height
-	heightJsonPayload = `{"jsonrpc":"2.0","method":"getSlot","params": [],"id":1}`
+	HeightJsonPayload = `{"jsonrpc":"2.0","method":"getSlot","params": [],"id":1}`
 )
 
 type solanaHeightResponse struct {
@@ -41,7 +41,7 @@ func (c *SolanaHeightCheck) Perform() {
 	if len(c.NodeList) == 0 || !c.IsSolanaChain(c.NodeList[0]) {
 		return
 	}
-	checks.PerformDefaultHeightCheck(c.Check, heightJsonPayload, "", c.getHeightFromNodeResponse, c.logger)
+	checks.PerformDefaultHeightCheck(c.Check, "POST", HeightJsonPayload, "", ParseHeight, c.logger)
 	c.nextCheckTime = time.Now().Add(solanaHeightCheckInterval)
 }
 
@@ -53,7 +53,8 @@ func (c *SolanaHeightCheck) ShouldRun() bool {
 	return time.Now().After(c.nextCheckTime)
 }
 
-func (c *SolanaHeightCheck) getHeightFromNodeResponse(response string) (uint64, error) {
+// ParseHeight - parses the height from the response to HeightJsonPayload, also used to probe altruists.
+func ParseHeight(response string) (uint64, error) {
 	var solanaRsp solanaHeightResponse
 	err := json.Unmarshal([]byte(response), &solanaRsp)
 	if err != nil {
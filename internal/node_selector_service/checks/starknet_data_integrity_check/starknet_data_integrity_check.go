@@ -0,0 +1,76 @@
+package starknet_data_integrity_check
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+const (
+	// how often the job should run
+	dataIntegrityCheckInterval = time.Second * 1
+
+	//json rpc payload to send a data integrity check
+	blockPayloadFmt = `{"jsonrpc":"2.0","method":"starknet_getBlockWithTxHashes","params":[{"block_number":%d}],"id":1}`
+)
+
+type blockResponse struct {
+	Result struct {
+		BlockHash string `json:"block_hash"`
+	} `json:"result"`
+}
+
+type StarknetDataIntegrityCheck struct {
+	*checks.Check
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewStarknetDataIntegrityCheck(check *checks.Check, logger *zap.Logger) *StarknetDataIntegrityCheck {
+	return &StarknetDataIntegrityCheck{Check: check, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *StarknetDataIntegrityCheck) Name() string {
+	return "starknet_data_integrity_check"
+}
+
+func (c *StarknetDataIntegrityCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *StarknetDataIntegrityCheck) Perform() {
+
+	// Session is not meant for Starknet
+	if len(c.NodeList) == 0 || c.GetChainFamily(c.NodeList[0]) != checks.ChainFamilyStarknet {
+		return
+	}
+	checks.PerformDataIntegrityCheck(c.Check, getBlockByNumberRequest, getBlockHashFromNodeResponse, c.logger)
+	c.nextCheckTime = time.Now().Add(dataIntegrityCheckInterval)
+}
+
+func (c *StarknetDataIntegrityCheck) ShouldRun() bool {
+	return c.nextCheckTime.IsZero() || time.Now().After(c.nextCheckTime)
+}
+
+func getBlockByNumberRequest(blockNumber uint64) checks.BlockRequest {
+	return checks.BlockRequest{Method: "POST", Payload: fmt.Sprintf(blockPayloadFmt, blockNumber)}
+}
+
+// getBlockHashFromNodeResponse - returns the hash of the block. Hashes are felts that clients format with or without leading
+// zeros, so they are normalized before being compared.
+func getBlockHashFromNodeResponse(response string) (string, error) {
+	var blockRsp blockResponse
+	if err := json.Unmarshal([]byte(response), &blockRsp); err != nil {
+		return "", err
+	}
+	if blockRsp.Result.BlockHash == "" {
+		return "", errors.New("missing block hash")
+	}
+	hash := strings.TrimLeft(strings.TrimPrefix(strings.ToLower(blockRsp.Result.BlockHash), "0x"), "0")
+	return "0x" + hash, nil
+}
@@ -0,0 +1,38 @@
+package starknet_data_integrity_check
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetBlockHashFromNodeResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "block",
+			response: `{"jsonrpc":"2.0","result":{"block_hash":"0x4e1f77f39545afe866ac151ac908bd1a347a2a8a7d58bef1276db4f06fdf2f6","block_number":652386,"parent_hash":"0x2a70fb03fe363a2d6be843343a1d81ce6abeda1e9bd5cc6ad8fa9f45e30fdeb","status":"ACCEPTED_ON_L1","transactions":[]},"id":1}`,
+			expected: "0x4e1f77f39545afe866ac151ac908bd1a347a2a8a7d58bef1276db4f06fdf2f6",
+		},
+		{
+			name:     "block hash with leading zeros",
+			response: `{"jsonrpc":"2.0","result":{"block_hash":"0x04E1F77F39545AFE866AC151AC908BD1A347A2A8A7D58BEF1276DB4F06FDF2F6","block_number":652386},"id":1}`,
+			expected: "0x4e1f77f39545afe866ac151ac908bd1a347a2a8a7d58bef1276db4f06fdf2f6",
+		},
+		{
+			name:     "block not found",
+			response: `{"jsonrpc":"2.0","error":{"code":24,"message":"Block not found"},"id":1}`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := getBlockHashFromNodeResponse(tt.response)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, hash)
+		})
+	}
+}
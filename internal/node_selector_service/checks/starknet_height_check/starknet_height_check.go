@@ -0,0 +1,67 @@
+package starknet_height_check
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+
+	// interval to run the starknet height check
+	starknetHeightCheckInterval = time.Second * 1
+
+	// jsonrpc payload to retrieve the starknet block number
+	HeightJsonPayload = `{"jsonrpc":"2.0","method":"starknet_blockNumber","params":[],"id":1}`
+)
+
+type blockNumberResponse struct {
+	Result *uint64 `json:"result"`
+}
+
+type StarknetHeightCheck struct {
+	*checks.Check
+	nextCheckTime time.Time
+	logger        *zap.Logger
+}
+
+func NewStarknetHeightCheck(check *checks.Check, logger *zap.Logger) *StarknetHeightCheck {
+	return &StarknetHeightCheck{Check: check, nextCheckTime: time.Time{}, logger: logger}
+}
+
+func (c *StarknetHeightCheck) Name() string {
+	return "starknet_height_check"
+}
+
+func (c *StarknetHeightCheck) Perform() {
+
+	// Session is not meant for Starknet
+	if len(c.NodeList) == 0 || c.GetChainFamily(c.NodeList[0]) != checks.ChainFamilyStarknet {
+		return
+	}
+	checks.PerformDefaultHeightCheck(c.Check, "POST", HeightJsonPayload, "", ParseHeight, c.logger)
+	c.nextCheckTime = time.Now().Add(starknetHeightCheckInterval)
+}
+
+func (c *StarknetHeightCheck) SetNodes(nodes []*models.QosNode) {
+	c.NodeList = nodes
+}
+
+func (c *StarknetHeightCheck) ShouldRun() bool {
+	return time.Now().After(c.nextCheckTime)
+}
+
+// ParseHeight - parses the height from the response to HeightJsonPayload, also used to probe altruists.
+func ParseHeight(response string) (uint64, error) {
+	var blockNumberRsp blockNumberResponse
+	if err := json.Unmarshal([]byte(response), &blockNumberRsp); err != nil {
+		return 0, err
+	}
+	if blockNumberRsp.Result == nil {
+		return 0, errors.New("missing block number")
+	}
+	return *blockNumberRsp.Result, nil
+}
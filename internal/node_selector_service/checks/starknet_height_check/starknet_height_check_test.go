@@ -0,0 +1,38 @@
+package starknet_height_check
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseHeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected uint64
+		wantErr  bool
+	}{
+		{
+			name:     "block number",
+			response: `{"jsonrpc":"2.0","result":652411,"id":1}`,
+			expected: 652411,
+		},
+		{
+			name:     "error",
+			response: `{"jsonrpc":"2.0","error":{"code":32,"message":"No blocks"},"id":1}`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid json",
+			response: `<html>Bad Gateway</html>`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			height, err := ParseHeight(tt.response)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, height)
+		})
+	}
+}
@@ -3,7 +3,9 @@ package models
 import (
 	"github.com/influxdata/tdigest"
 	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"maps"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -21,11 +23,15 @@ const (
 	DataIntegrityTimeout TimeoutReason = "invalid_data_timeout"
 	MaximumRelaysTimeout TimeoutReason = "maximum_relays_timeout"
 	NodeResponseTimeout  TimeoutReason = "node_response_timeout"
+	WrongChainTimeout    TimeoutReason = "wrong_chain_timeout"
+	// UnexpectedValueTimeout is set by custom checks whose expected value a node does not answer with
+	UnexpectedValueTimeout TimeoutReason = "unexpected_value_timeout"
 )
 
+// LatencyTracker - every operation on the t-digest can compress it, so reads also take the lock exclusively.
 type LatencyTracker struct {
 	tDigest *tdigest.TDigest
-	lock    sync.RWMutex
+	lock    sync.Mutex
 }
 
 func (l *LatencyTracker) RecordMeasurement(time float64) {
@@ -35,13 +41,25 @@ func (l *LatencyTracker) RecordMeasurement(time float64) {
 }
 
 func (l *LatencyTracker) GetMeasurementCount() float64 {
-	l.lock.RLock()
-	defer l.lock.RUnlock()
+	l.lock.Lock()
+	defer l.lock.Unlock()
 	return l.tDigest.Count()
 }
 
+// GetP90Latency - returns the P90 latency in milliseconds, or NaN if there are no measurements.
 func (l *LatencyTracker) GetP90Latency() float64 {
-	return l.tDigest.Quantile(.90)
+	return l.getQuantile(.90)
+}
+
+// GetP50Latency - returns the P50 latency in milliseconds, or NaN if there are no measurements.
+func (l *LatencyTracker) GetP50Latency() float64 {
+	return l.getQuantile(.50)
+}
+
+func (l *LatencyTracker) getQuantile(quantile float64) float64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.tDigest.Quantile(quantile)
 }
 
 type SessionChainKey struct {
@@ -49,61 +67,124 @@ type SessionChainKey struct {
 	Chain         string `json:"chain"`
 }
 
+// NodeHealth - an immutable snapshot of the health of a node. Checks and relays update the health by swapping in an
+// updated copy, so a snapshot is always consistent and can be read without a lock.
+type NodeHealth struct {
+	TimeoutUntil        time.Time
+	TimeoutReason       TimeoutReason
+	LastKnownError      error
+	Synced              bool
+	Archival            bool
+	LatestKnownHeight   uint64
+	LastHeightCheckTime time.Time
+}
+
+func (h NodeHealth) IsHealthy() bool {
+	return !h.IsInTimeout() && h.Synced
+}
+
+func (h NodeHealth) IsInTimeout() bool {
+	return !h.TimeoutUntil.IsZero() && time.Now().Before(h.TimeoutUntil)
+}
+
+func (h NodeHealth) GetLastKnownErrorStr() string {
+	if h.LastKnownError == nil {
+		return ""
+	}
+	errStr := h.LastKnownError.Error()
+	if len(errStr) > maxErrorStr {
+		return errStr[:maxErrorStr]
+	}
+	return errStr
+}
+
+// nodeCheckTimes - an immutable snapshot of when the checks last ran against a node, swapped like the node's health.
+type nodeCheckTimes struct {
+	lastDataIntegrityCheckTime time.Time
+	lastChainIdCheckTime       time.Time
+	lastArchivalCheckTime      time.Time
+	lastCustomCheckTimes       map[string]time.Time // chain check id > last check time
+}
+
 // QosNode a FAT model to store the QoS information of a specific node in a session.
 type QosNode struct {
-	MorseNode                  *models.Node
-	MorseSession               *models.Session
-	MorseSigner                *models.Ed25519Account
-	LatencyTracker             *LatencyTracker
-	timeoutUntil               time.Time
-	timeoutReason              TimeoutReason
-	lastDataIntegrityCheckTime time.Time
-	latestKnownHeight          uint64
-	synced                     bool
-	lastKnownError             error
-	lastHeightCheckTime        time.Time
+	MorseNode           *models.Node
+	MorseSession        *models.Session
+	MorseSigner         *models.Ed25519Account
+	LatencyTracker      *LatencyTracker
+	health              atomic.Pointer[NodeHealth]
+	checkTimes          atomic.Pointer[nodeCheckTimes]
+	outstandingRequests atomic.Int64
 }
 
 func NewQosNode(morseNode *models.Node, pocketSession *models.Session, appSigner *models.Ed25519Account) *QosNode {
-	return &QosNode{MorseNode: morseNode, MorseSession: pocketSession, MorseSigner: appSigner, LatencyTracker: &LatencyTracker{tDigest: tdigest.NewWithCompression(latencyCompression)}}
+	node := &QosNode{MorseNode: morseNode, MorseSession: pocketSession, MorseSigner: appSigner, LatencyTracker: &LatencyTracker{tDigest: tdigest.NewWithCompression(latencyCompression)}}
+	node.health.Store(&NodeHealth{})
+	node.checkTimes.Store(&nodeCheckTimes{})
+	return node
+}
+
+// updateSnapshot - swaps the snapshot for an updated copy, the update is retried if the snapshot was swapped concurrently.
+func updateSnapshot[T any](snapshot *atomic.Pointer[T], update func(updated *T)) {
+	for {
+		current := snapshot.Load()
+		updated := *current
+		update(&updated)
+		if snapshot.CompareAndSwap(current, &updated) {
+			return
+		}
+	}
+}
+
+// GetHealth - returns the node's current health, callers that read several health fields should read them from one snapshot.
+func (n *QosNode) GetHealth() NodeHealth {
+	return *n.health.Load()
 }
 
 func (n *QosNode) IsHealthy() bool {
-	return !n.IsInTimeout() && n.IsSynced()
+	return n.GetHealth().IsHealthy()
 }
 
 func (n *QosNode) IsSynced() bool {
-	return n.synced
+	return n.GetHealth().Synced
 }
 
 func (n *QosNode) SetSynced(synced bool) {
-	n.synced = synced
+	updateSnapshot(&n.health, func(health *NodeHealth) {
+		health.Synced = synced
+	})
 }
 
 func (n *QosNode) IsInTimeout() bool {
-	return !n.timeoutUntil.IsZero() && time.Now().Before(n.timeoutUntil)
+	return n.GetHealth().IsInTimeout()
 }
 
 func (n *QosNode) GetLastHeightCheckTime() time.Time {
-	return n.lastHeightCheckTime
+	return n.GetHealth().LastHeightCheckTime
 }
 
 func (n *QosNode) SetTimeoutUntil(time time.Time, reason TimeoutReason, attachedErr error) {
-	n.timeoutReason = reason
-	n.timeoutUntil = time
-	n.lastKnownError = attachedErr
+	updateSnapshot(&n.health, func(health *NodeHealth) {
+		health.TimeoutReason = reason
+		health.TimeoutUntil = time
+		health.LastKnownError = attachedErr
+	})
 }
 
 func (n *QosNode) SetLastKnownHeight(lastKnownHeight uint64) {
-	n.latestKnownHeight = lastKnownHeight
+	updateSnapshot(&n.health, func(health *NodeHealth) {
+		health.LatestKnownHeight = lastKnownHeight
+	})
 }
 
 func (n *QosNode) SetLastHeightCheckTime(time time.Time) {
-	n.lastHeightCheckTime = time
+	updateSnapshot(&n.health, func(health *NodeHealth) {
+		health.LastHeightCheckTime = time
+	})
 }
 
 func (n *QosNode) GetLastKnownHeight() uint64 {
-	return n.latestKnownHeight
+	return n.GetHealth().LatestKnownHeight
 }
 
 func (n *QosNode) GetChain() string {
@@ -119,31 +200,86 @@ func (n *QosNode) GetAppStakeSigner() *models.Ed25519Account {
 }
 
 func (n *QosNode) GetLastDataIntegrityCheckTime() time.Time {
-	return n.lastDataIntegrityCheckTime
+	return n.checkTimes.Load().lastDataIntegrityCheckTime
 }
 func (n *QosNode) SetLastDataIntegrityCheckTime(lastDataIntegrityCheckTime time.Time) {
-	n.lastDataIntegrityCheckTime = lastDataIntegrityCheckTime
+	updateSnapshot(&n.checkTimes, func(checkTimes *nodeCheckTimes) {
+		checkTimes.lastDataIntegrityCheckTime = lastDataIntegrityCheckTime
+	})
+}
+
+func (n *QosNode) GetLastChainIdCheckTime() time.Time {
+	return n.checkTimes.Load().lastChainIdCheckTime
+}
+
+func (n *QosNode) SetLastChainIdCheckTime(lastChainIdCheckTime time.Time) {
+	updateSnapshot(&n.checkTimes, func(checkTimes *nodeCheckTimes) {
+		checkTimes.lastChainIdCheckTime = lastChainIdCheckTime
+	})
+}
+
+// IsArchival - returns whether the node serves historical state, nodes are not archival until the archival check has run.
+func (n *QosNode) IsArchival() bool {
+	return n.GetHealth().Archival
+}
+
+func (n *QosNode) SetArchival(archival bool) {
+	updateSnapshot(&n.health, func(health *NodeHealth) {
+		health.Archival = archival
+	})
+}
+
+func (n *QosNode) GetLastArchivalCheckTime() time.Time {
+	return n.checkTimes.Load().lastArchivalCheckTime
+}
+
+func (n *QosNode) SetLastArchivalCheckTime(lastArchivalCheckTime time.Time) {
+	updateSnapshot(&n.checkTimes, func(checkTimes *nodeCheckTimes) {
+		checkTimes.lastArchivalCheckTime = lastArchivalCheckTime
+	})
+}
+
+func (n *QosNode) GetLastCustomCheckTime(checkId string) time.Time {
+	return n.checkTimes.Load().lastCustomCheckTimes[checkId]
+}
+
+func (n *QosNode) SetLastCustomCheckTime(checkId string, lastCustomCheckTime time.Time) {
+	updateSnapshot(&n.checkTimes, func(checkTimes *nodeCheckTimes) {
+		// The map is shared with the previous snapshot, so it is copied before it is written
+		checkTimes.lastCustomCheckTimes = maps.Clone(checkTimes.lastCustomCheckTimes)
+		if checkTimes.lastCustomCheckTimes == nil {
+			checkTimes.lastCustomCheckTimes = map[string]time.Time{}
+		}
+		checkTimes.lastCustomCheckTimes[checkId] = lastCustomCheckTime
+	})
 }
 
 func (n *QosNode) GetTimeoutReason() TimeoutReason {
-	return n.timeoutReason
+	return n.GetHealth().TimeoutReason
 }
 
 func (n *QosNode) GetLastKnownErrorStr() string {
-	if n.lastKnownError == nil {
-		return ""
-	}
-	errStr := n.lastKnownError.Error()
-	if len(errStr) > maxErrorStr {
-		return errStr[:maxErrorStr]
-	}
-	return errStr
+	return n.GetHealth().GetLastKnownErrorStr()
 }
 
 func (n *QosNode) GetTimeoutUntil() time.Time {
-	return n.timeoutUntil
+	return n.GetHealth().TimeoutUntil
 }
 
 func (n *QosNode) GetLatencyTracker() *LatencyTracker {
 	return n.LatencyTracker
 }
+
+// IncrementOutstandingRequests - marks a relay as in-flight to the node
+func (n *QosNode) IncrementOutstandingRequests() {
+	n.outstandingRequests.Add(1)
+}
+
+// DecrementOutstandingRequests - marks an in-flight relay to the node as finished
+func (n *QosNode) DecrementOutstandingRequests() {
+	n.outstandingRequests.Add(-1)
+}
+
+func (n *QosNode) GetOutstandingRequests() int64 {
+	return n.outstandingRequests.Load()
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+)
+
+// TestNodeOption - sets up the state of a node created by NewTestQosNode.
+type TestNodeOption func(node *QosNode)
+
+// NewTestQosNode - creates a node of a chain for tests, the node is unsynced and unmeasured unless options say otherwise.
+func NewTestQosNode(publicKey string, chainId string, options ...TestNodeOption) *QosNode {
+	node := NewQosNode(&models.Node{PublicKey: publicKey}, &models.Session{SessionHeader: &models.SessionHeader{Chain: chainId}}, &models.Ed25519Account{})
+	for _, option := range options {
+		option(node)
+	}
+	return node
+}
+
+func WithSessionHeight(sessionHeight uint) TestNodeOption {
+	return func(node *QosNode) {
+		node.MorseSession.SessionHeader.SessionHeight = sessionHeight
+	}
+}
+
+func WithSynced() TestNodeOption {
+	return func(node *QosNode) {
+		node.SetSynced(true)
+	}
+}
+
+func WithLastKnownHeight(lastKnownHeight uint64) TestNodeOption {
+	return func(node *QosNode) {
+		node.SetLastKnownHeight(lastKnownHeight)
+	}
+}
+
+// WithLatencies - records latency measurements (in milliseconds).
+func WithLatencies(latencies ...float64) TestNodeOption {
+	return func(node *QosNode) {
+		for _, latency := range latencies {
+			node.GetLatencyTracker().RecordMeasurement(latency)
+		}
+	}
+}
+
+// WithOutstandingRequests - marks a number of relays as in-flight.
+func WithOutstandingRequests(outstandingRequests int) TestNodeOption {
+	return func(node *QosNode) {
+		for i := 0; i < outstandingRequests; i++ {
+			node.IncrementOutstandingRequests()
+		}
+	}
+}
@@ -1,76 +1,136 @@
 package node_selector_service
 
 import (
+	"github.com/pokt-network/gateway-server/internal/chain_checks_registry"
 	"github.com/pokt-network/gateway-server/internal/chain_configurations_registry"
-	"github.com/pokt-network/gateway-server/internal/global_config"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/bitcoin_data_integrity_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/bitcoin_height_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/cosmos_data_integrity_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/cosmos_height_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/custom_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/evm_archival_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/evm_chain_id_check"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/evm_data_integrity_check"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/evm_height_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/near_data_integrity_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/near_height_check"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/pokt_data_integrity_check"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/pokt_height_check"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/solana_data_integrity_check"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/solana_height_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/starknet_data_integrity_check"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks/starknet_height_check"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/selection_strategy"
 	"github.com/pokt-network/gateway-server/internal/session_registry"
-	"github.com/pokt-network/gateway-server/pkg/common"
 	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0"
 	"go.uber.org/zap"
+	"math"
 	"sort"
 	"time"
 )
 
 const (
 	jobCheckInterval = time.Second
+	// default maximum P90 latency for a node to be placed in the top bucket if the chain does not have one configured
+	defaultTopBucketP90Latency = time.Millisecond * 150
+	// number of latency buckets nodes are grouped in, nodes slower than the second to last bucket fall into the last bucket
+	latencyBucketCount = 5
 )
 
+// NodeFilter - restricts the nodes that can be selected for a relay, returns true if the node can be selected.
+type NodeFilter func(node *models.QosNode) bool
+
 type NodeSelectorService interface {
-	FindNode(chainId string) (*models.QosNode, bool)
+	FindNode(chainId string, filters ...NodeFilter) (*models.QosNode, bool)
 }
 
-type NodeSelectorClient struct {
-	sessionRegistry session_registry.SessionRegistryService
-	pocketRelayer   pokt_v0.PocketRelayer
-	logger          *zap.Logger
-	checkJobs       []checks.CheckJob
+// ExcludeNodes - filters out nodes by their public key, such as nodes that were already attempted for a relay.
+func ExcludeNodes(excludedPublicKeys map[string]bool) NodeFilter {
+	return func(node *models.QosNode) bool {
+		return !excludedPublicKeys[node.GetPublicKey()]
+	}
 }
 
-func NewNodeSelectorService(sessionRegistry session_registry.SessionRegistryService, pocketRelayer pokt_v0.PocketRelayer, chainConfiguration chain_configurations_registry.ChainConfigurationsService, networkProvider global_config.ChainNetworkProvider, logger *zap.Logger) *NodeSelectorClient {
+// ArchivalNodes - filters out nodes that do not serve historical state.
+func ArchivalNodes() NodeFilter {
+	return func(node *models.QosNode) bool {
+		return node.IsArchival()
+	}
+}
 
-	// base checks will share same node list and pocket relayer
-	baseCheck := checks.NewCheck(pocketRelayer, chainConfiguration, networkProvider)
+type NodeSelectorClient struct {
+	sessionRegistry     session_registry.SessionRegistryService
+	pocketRelayer       pokt_v0.PocketRelayer
+	chainConfiguration  chain_configurations_registry.ChainConfigurationsService
+	chainChecks         chain_checks_registry.ChainChecksService
+	logger              *zap.Logger
+	checkScheduler      *checkScheduler
+	selectionStrategies map[string]selection_strategy.SelectionStrategy
+}
+
+func NewNodeSelectorService(sessionRegistry session_registry.SessionRegistryService, pocketRelayer pokt_v0.PocketRelayer, chainConfiguration chain_configurations_registry.ChainConfigurationsService, chainChecks chain_checks_registry.ChainChecksService, logger *zap.Logger) *NodeSelectorClient {
 
-	// enabled checks
-	enabledChecks := []checks.CheckJob{
-		evm_height_check.NewEvmHeightCheck(baseCheck, logger.Named("evm_height_checker")),
-		evm_data_integrity_check.NewEvmDataIntegrityCheck(baseCheck, logger.Named("evm_data_integrity_checker")),
-		solana_height_check.NewSolanaHeightCheck(baseCheck, logger.Named("solana_height_check")),
-		solana_data_integrity_check.NewSolanaDataIntegrityCheck(baseCheck, logger.Named("solana_data_integrity_check")),
-		pokt_height_check.NewPoktHeightCheck(baseCheck, logger.Named("pokt_height_check")),
-		pokt_data_integrity_check.NewPoktDataIntegrityCheck(baseCheck, logger.Named("pokt_data_integrity_check")),
+	// enabled checks, created for every session. Every job has its own base check, so it can be given its own node list.
+	enabledChecks := func() []checks.CheckJob {
+		newCheck := func() *checks.Check {
+			return checks.NewCheck(pocketRelayer, chainConfiguration)
+		}
+		return []checks.CheckJob{
+			evm_height_check.NewEvmHeightCheck(newCheck(), logger.Named("evm_height_checker")),
+			evm_data_integrity_check.NewEvmDataIntegrityCheck(newCheck(), logger.Named("evm_data_integrity_checker")),
+			evm_chain_id_check.NewEvmChainIdCheck(newCheck(), logger.Named("evm_chain_id_checker")),
+			evm_archival_check.NewEvmArchivalCheck(newCheck(), logger.Named("evm_archival_checker")),
+			solana_height_check.NewSolanaHeightCheck(newCheck(), logger.Named("solana_height_check")),
+			solana_data_integrity_check.NewSolanaDataIntegrityCheck(newCheck(), logger.Named("solana_data_integrity_check")),
+			pokt_height_check.NewPoktHeightCheck(newCheck(), logger.Named("pokt_height_check")),
+			pokt_data_integrity_check.NewPoktDataIntegrityCheck(newCheck(), logger.Named("pokt_data_integrity_check")),
+			cosmos_height_check.NewCosmosHeightCheck(newCheck(), logger.Named("cosmos_height_check")),
+			cosmos_data_integrity_check.NewCosmosDataIntegrityCheck(newCheck(), logger.Named("cosmos_data_integrity_check")),
+			near_height_check.NewNearHeightCheck(newCheck(), logger.Named("near_height_check")),
+			near_data_integrity_check.NewNearDataIntegrityCheck(newCheck(), logger.Named("near_data_integrity_check")),
+			bitcoin_height_check.NewBitcoinHeightCheck(newCheck(), logger.Named("bitcoin_height_check")),
+			bitcoin_data_integrity_check.NewBitcoinDataIntegrityCheck(newCheck(), logger.Named("bitcoin_data_integrity_check")),
+			starknet_height_check.NewStarknetHeightCheck(newCheck(), logger.Named("starknet_height_check")),
+			starknet_data_integrity_check.NewStarknetDataIntegrityCheck(newCheck(), logger.Named("starknet_data_integrity_check")),
+			custom_check.NewCustomCheck(newCheck(), chainChecks, logger.Named("custom_check")),
+		}
 	}
 	selectorService := &NodeSelectorClient{
-		sessionRegistry: sessionRegistry,
-		logger:          logger,
-		checkJobs:       enabledChecks,
+		sessionRegistry:     sessionRegistry,
+		chainConfiguration:  chainConfiguration,
+		chainChecks:         chainChecks,
+		logger:              logger,
+		checkScheduler:      newCheckScheduler(sessionRegistry, enabledChecks, checkWorkerCount, checkJobTimeout, logger.Named("check_scheduler")),
+		selectionStrategies: selection_strategy.NewSelectionStrategies(),
 	}
-	selectorService.startJobChecker()
+	selectorService.checkScheduler.start()
 	return selectorService
 }
 
-func (q NodeSelectorClient) FindNode(chainId string) (*models.QosNode, bool) {
+// Stop - stops the QoS checks of every session, waiting for the running checks to finish or time out.
+func (q *NodeSelectorClient) Stop() {
+	q.checkScheduler.stop()
+}
+
+func (q NodeSelectorClient) FindNode(chainId string, filters ...NodeFilter) (*models.QosNode, bool) {
 
 	nodes := q.sessionRegistry.GetNodesByChain(chainId)
 	if len(nodes) == 0 {
 		return nil, false
 	}
 
-	// Filter nodes by health
-	healthyNodes := filterByHealthyNodes(nodes)
+	// Filter nodes by health and caller provided filters
+	healthyNodes := filterByNodeFilters(filterByHealthyNodes(nodes, q.isSyncChecked(chainId)), filters)
 
 	// Find a node that's closer to session height
 	sortedSessionHeights, nodeMap := filterBySessionHeightNodes(healthyNodes)
+	topBucketP90Latency := q.getTopBucketP90Latency(chainId)
+	strategy := q.getSelectionStrategy(chainId)
 	for _, sessionHeight := range sortedSessionHeights {
-		node, ok := common.GetRandomElement(nodeMap[sessionHeight])
+		// Prefer the fastest nodes within the session, slower nodes are only used if faster buckets are empty
+		node, ok := strategy.SelectNode(chainId, filterByFastestLatencyBucket(nodeMap[sessionHeight], topBucketP90Latency))
 		if ok {
 			return node, true
 		}
@@ -78,6 +138,62 @@ func (q NodeSelectorClient) FindNode(chainId string) (*models.QosNode, bool) {
 	return nil, false
 }
 
+// filterByFastestLatencyBucket - groups nodes into latency buckets by their P90 latency and returns the fastest non-empty bucket.
+// Bucket i holds nodes with P90 latency in (i * topBucketP90Latency, (i+1) * topBucketP90Latency], while the last bucket holds every slower node.
+// Nodes without latency measurements are placed in the top bucket, so they receive traffic and can be measured.
+func filterByFastestLatencyBucket(nodes []*models.QosNode, topBucketP90Latency time.Duration) []*models.QosNode {
+	latencyBuckets := make([][]*models.QosNode, latencyBucketCount)
+	for _, node := range nodes {
+		bucket := getLatencyBucket(node.GetLatencyTracker().GetP90Latency(), topBucketP90Latency)
+		latencyBuckets[bucket] = append(latencyBuckets[bucket], node)
+	}
+	for _, bucket := range latencyBuckets {
+		if len(bucket) > 0 {
+			return bucket
+		}
+	}
+	return nil
+}
+
+// getLatencyBucket - returns the bucket index for a P90 latency in milliseconds
+func getLatencyBucket(p90LatencyMs float64, topBucketP90Latency time.Duration) int {
+	topBucketMs := float64(topBucketP90Latency.Milliseconds())
+	if math.IsNaN(p90LatencyMs) || p90LatencyMs <= topBucketMs || topBucketMs <= 0 {
+		return 0
+	}
+	bucket := int(math.Ceil(p90LatencyMs/topBucketMs)) - 1
+	if bucket >= latencyBucketCount {
+		return latencyBucketCount - 1
+	}
+	return bucket
+}
+
+// getSelectionStrategy - returns the strategy configured for the chain, or the default strategy if none or an unknown one is configured.
+func (q NodeSelectorClient) getSelectionStrategy(chainId string) selection_strategy.SelectionStrategy {
+	chainConfig, ok := q.chainConfiguration.GetChainConfiguration(chainId)
+	if !ok || chainConfig.NodeSelectionStrategy.String == "" {
+		return q.selectionStrategies[selection_strategy.DefaultStrategy]
+	}
+	strategy, ok := q.selectionStrategies[chainConfig.NodeSelectionStrategy.String]
+	if !ok {
+		q.logger.Sugar().Warnw("unknown node selection strategy, using default", "chain", chainId, "strategy", chainConfig.NodeSelectionStrategy.String)
+		return q.selectionStrategies[selection_strategy.DefaultStrategy]
+	}
+	return strategy
+}
+
+func (q NodeSelectorClient) getTopBucketP90Latency(chainId string) time.Duration {
+	chainConfig, ok := q.chainConfiguration.GetChainConfiguration(chainId)
+	if !ok {
+		return defaultTopBucketP90Latency
+	}
+	configTime, err := time.ParseDuration(chainConfig.TopBucketP90latencyDuration.String)
+	if err != nil {
+		return defaultTopBucketP90Latency
+	}
+	return configTime
+}
+
 // filterBySessionHeightNodes - filter by session height descending. This allows node selector to send relays with
 // latest session height which nodes are more likely to serve vs session rollover relays.
 func filterBySessionHeightNodes(nodes []*models.QosNode) ([]uint, map[uint][]*models.QosNode) {
@@ -103,32 +219,50 @@ func filterBySessionHeightNodes(nodes []*models.QosNode) ([]uint, map[uint][]*mo
 	return sortedSessionHeights, nodesBySessionHeight
 }
 
-func filterByHealthyNodes(nodes []*models.QosNode) []*models.QosNode {
+// isSyncChecked - returns whether a check marks the chain's nodes as synced. Nodes of none chains are only marked by a
+// custom height check, so without one they are never synced.
+func (q NodeSelectorClient) isSyncChecked(chainId string) bool {
+	if checks.GetChainFamily(q.chainConfiguration, chainId) != checks.ChainFamilyNone {
+		return true
+	}
+	for _, chainCheck := range q.chainChecks.GetChainChecks(chainId) {
+		if chainCheck.Comparison == chain_checks_registry.ComparisonHeightTolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// filterByHealthyNodes - filters out unhealthy nodes, nodes of chains whose sync is not checked only need to be out of timeout.
+func filterByHealthyNodes(nodes []*models.QosNode, syncChecked bool) []*models.QosNode {
 	var healthyNodes []*models.QosNode
 
 	for _, r := range nodes {
-		if r.IsHealthy() {
+		if r.IsHealthy() || (!syncChecked && !r.IsInTimeout()) {
 			healthyNodes = append(healthyNodes, r)
 		}
 	}
 	return healthyNodes
 }
 
-func (q NodeSelectorClient) startJobChecker() {
-	ticker := time.Tick(jobCheckInterval)
-	go func() {
-		for {
-			select {
-			case <-ticker:
-				for _, job := range q.checkJobs {
-					if job.ShouldRun() {
-						for _, nodes := range q.sessionRegistry.GetNodesMap() {
-							job.SetNodes(nodes.Value())
-							job.Perform()
-						}
-					}
-				}
-			}
+func filterByNodeFilters(nodes []*models.QosNode, filters []NodeFilter) []*models.QosNode {
+	if len(filters) == 0 {
+		return nodes
+	}
+	var filteredNodes []*models.QosNode
+	for _, r := range nodes {
+		if isNodeAllowed(r, filters) {
+			filteredNodes = append(filteredNodes, r)
+		}
+	}
+	return filteredNodes
+}
+
+func isNodeAllowed(node *models.QosNode, filters []NodeFilter) bool {
+	for _, filter := range filters {
+		if !filter(node) {
+			return false
 		}
-	}()
+	}
+	return true
 }
@@ -0,0 +1,177 @@
+package node_selector_service
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/chain_checks_registry"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/selection_strategy"
+	chain_checks_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_checks_registry"
+	chain_configurations_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_configurations_registry"
+	session_registry_mock "github.com/pokt-network/gateway-server/mocks/session_registry"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"math"
+	"testing"
+	"time"
+)
+
+type NodeSelectorTestSuite struct {
+	suite.Suite
+	mockSessionRegistryService     *session_registry_mock.SessionRegistryService
+	mockChainConfigurationsService *chain_configurations_registry_mock.ChainConfigurationsService
+	mockChainChecksService         *chain_checks_registry_mock.ChainChecksService
+	nodeSelector                   *NodeSelectorClient
+}
+
+func (suite *NodeSelectorTestSuite) SetupTest() {
+	suite.mockSessionRegistryService = new(session_registry_mock.SessionRegistryService)
+	suite.mockChainConfigurationsService = new(chain_configurations_registry_mock.ChainConfigurationsService)
+	suite.mockChainChecksService = new(chain_checks_registry_mock.ChainChecksService)
+	suite.nodeSelector = &NodeSelectorClient{
+		sessionRegistry:     suite.mockSessionRegistryService,
+		chainConfiguration:  suite.mockChainConfigurationsService,
+		chainChecks:         suite.mockChainChecksService,
+		logger:              zap.NewNop(),
+		selectionStrategies: selection_strategy.NewSelectionStrategies(),
+	}
+}
+
+func (suite *NodeSelectorTestSuite) TestFindNode() {
+
+	topBucketLatency := pgtype.Varchar{}
+	topBucketLatency.Set("100ms")
+
+	testCases := []struct {
+		name                string
+		nodes               []*models.QosNode
+		expectedPublicKeys  []string
+		expectedNodeIsFound bool
+	}{
+		{
+			name:                "NoNodes",
+			nodes:               []*models.QosNode{},
+			expectedPublicKeys:  nil,
+			expectedNodeIsFound: false,
+		},
+		{
+			name: "PrefersTopLatencyBucket",
+			nodes: []*models.QosNode{
+				models.NewTestQosNode("slow", "1234", models.WithSessionHeight(1), models.WithSynced(), models.WithLatencies(2000)),
+				models.NewTestQosNode("fast", "1234", models.WithSessionHeight(1), models.WithSynced(), models.WithLatencies(80)),
+			},
+			expectedPublicKeys:  []string{"fast"},
+			expectedNodeIsFound: true,
+		},
+		{
+			name: "FallsBackToSlowerBucket",
+			nodes: []*models.QosNode{
+				models.NewTestQosNode("slowest", "1234", models.WithSessionHeight(1), models.WithSynced(), models.WithLatencies(2000)),
+				models.NewTestQosNode("slower", "1234", models.WithSessionHeight(1), models.WithSynced(), models.WithLatencies(150)),
+			},
+			expectedPublicKeys:  []string{"slower"},
+			expectedNodeIsFound: true,
+		},
+		{
+			name: "UnmeasuredNodesAreInTopBucket",
+			nodes: []*models.QosNode{
+				models.NewTestQosNode("slow", "1234", models.WithSessionHeight(1), models.WithSynced(), models.WithLatencies(500)),
+				models.NewTestQosNode("new", "1234", models.WithSessionHeight(1), models.WithSynced()),
+			},
+			expectedPublicKeys:  []string{"new"},
+			expectedNodeIsFound: true,
+		},
+		{
+			name: "PrefersLatestSessionHeight",
+			nodes: []*models.QosNode{
+				models.NewTestQosNode("old", "1234", models.WithSessionHeight(1), models.WithSynced(), models.WithLatencies(50)),
+				models.NewTestQosNode("latest", "1234", models.WithSessionHeight(5), models.WithSynced(), models.WithLatencies(500)),
+			},
+			expectedPublicKeys:  []string{"latest"},
+			expectedNodeIsFound: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockSessionRegistryService.EXPECT().GetNodesByChain("1234").Return(tc.nodes)
+			suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{ChainFamily: pgtype.Varchar{String: "evm", Status: pgtype.Present}, TopBucketP90latencyDuration: topBucketLatency}, true).Maybe()
+
+			node, ok := suite.nodeSelector.FindNode("1234")
+
+			suite.Equal(tc.expectedNodeIsFound, ok)
+			if tc.expectedNodeIsFound {
+				suite.Contains(tc.expectedPublicKeys, node.GetPublicKey())
+			}
+		})
+	}
+}
+
+func (suite *NodeSelectorTestSuite) TestFindNodeOfUncheckedChain() {
+	testCases := []struct {
+		name                string
+		chainChecks         []*chain_checks_registry.ChainCheck
+		timedOut            bool
+		expectedNodeIsFound bool
+	}{
+		{
+			name:                "UnsyncedNodeIsSelectable",
+			expectedNodeIsFound: true,
+		},
+		{
+			name:                "TimedOutNodeIsNotSelectable",
+			timedOut:            true,
+			expectedNodeIsFound: false,
+		},
+		{
+			name:                "CustomHeightCheckRequiresSync",
+			chainChecks:         []*chain_checks_registry.ChainCheck{{Comparison: chain_checks_registry.ComparisonHeightTolerance}},
+			expectedNodeIsFound: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			// Nodes of chains without a chain configuration are never marked as synced by the built-in checks
+			node := models.NewTestQosNode("unchecked", "5678", models.WithSessionHeight(1))
+			if tc.timedOut {
+				node.SetTimeoutUntil(time.Now().Add(time.Minute), models.NodeResponseTimeout, nil)
+			}
+			suite.mockSessionRegistryService.EXPECT().GetNodesByChain("5678").Return([]*models.QosNode{node})
+			suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("5678").Return(db_query.GetChainConfigurationsRow{}, false)
+			suite.mockChainChecksService.EXPECT().GetChainChecks("5678").Return(tc.chainChecks)
+
+			foundNode, ok := suite.nodeSelector.FindNode("5678")
+
+			suite.Equal(tc.expectedNodeIsFound, ok)
+			if tc.expectedNodeIsFound {
+				suite.Same(node, foundNode)
+			}
+		})
+	}
+}
+
+func (suite *NodeSelectorTestSuite) TestGetLatencyBucket() {
+	testCases := []struct {
+		name           string
+		p90Latency     float64
+		expectedBucket int
+	}{
+		{name: "NoMeasurements", p90Latency: math.NaN(), expectedBucket: 0},
+		{name: "WithinTopBucket", p90Latency: 100, expectedBucket: 0},
+		{name: "SecondBucket", p90Latency: 101, expectedBucket: 1},
+		{name: "ThirdBucket", p90Latency: 300, expectedBucket: 2},
+		{name: "SlowerThanAllBuckets", p90Latency: 10000, expectedBucket: latencyBucketCount - 1},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.expectedBucket, getLatencyBucket(tc.p90Latency, time.Millisecond*100))
+		})
+	}
+}
+
+func TestNodeSelectorTestSuite(t *testing.T) {
+	suite.Run(t, new(NodeSelectorTestSuite))
+}
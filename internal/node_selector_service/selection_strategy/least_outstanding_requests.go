@@ -0,0 +1,34 @@
+package selection_strategy
+
+import (
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"github.com/pokt-network/gateway-server/pkg/common"
+)
+
+const LeastOutstandingRequestsStrategyName = "least_outstanding_requests"
+
+// LeastOutstandingRequestsStrategy picks the node with the fewest in-flight relays, ties are broken at random.
+type LeastOutstandingRequestsStrategy struct{}
+
+func NewLeastOutstandingRequestsStrategy() *LeastOutstandingRequestsStrategy {
+	return &LeastOutstandingRequestsStrategy{}
+}
+
+func (s *LeastOutstandingRequestsStrategy) Name() string {
+	return LeastOutstandingRequestsStrategyName
+}
+
+func (s *LeastOutstandingRequestsStrategy) SelectNode(chainId string, nodes []*models.QosNode) (*models.QosNode, bool) {
+	var leastBusyNodes []*models.QosNode
+	var leastOutstandingRequests int64
+	for _, node := range nodes {
+		outstandingRequests := node.GetOutstandingRequests()
+		if len(leastBusyNodes) == 0 || outstandingRequests < leastOutstandingRequests {
+			leastBusyNodes = []*models.QosNode{node}
+			leastOutstandingRequests = outstandingRequests
+		} else if outstandingRequests == leastOutstandingRequests {
+			leastBusyNodes = append(leastBusyNodes, node)
+		}
+	}
+	return common.GetRandomElement(leastBusyNodes)
+}
@@ -0,0 +1,56 @@
+package selection_strategy
+
+import (
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"math"
+	"math/rand"
+)
+
+const PowerOfTwoChoicesStrategyName = "power_of_two_choices"
+
+// PowerOfTwoChoicesStrategy picks two distinct nodes at random and uses the one with fewer in-flight relays.
+// Ties are broken by the lower P90 latency. This avoids the herding behavior of always picking the least busy node.
+type PowerOfTwoChoicesStrategy struct{}
+
+func NewPowerOfTwoChoicesStrategy() *PowerOfTwoChoicesStrategy {
+	return &PowerOfTwoChoicesStrategy{}
+}
+
+func (s *PowerOfTwoChoicesStrategy) Name() string {
+	return PowerOfTwoChoicesStrategyName
+}
+
+func (s *PowerOfTwoChoicesStrategy) SelectNode(chainId string, nodes []*models.QosNode) (*models.QosNode, bool) {
+	switch len(nodes) {
+	case 0:
+		return nil, false
+	case 1:
+		return nodes[0], true
+	}
+
+	first := rand.Intn(len(nodes))
+	// pick a second index that is different from the first one
+	second := rand.Intn(len(nodes) - 1)
+	if second >= first {
+		second++
+	}
+	return pickLessLoadedNode(nodes[first], nodes[second]), true
+}
+
+func pickLessLoadedNode(a *models.QosNode, b *models.QosNode) *models.QosNode {
+	outstandingA := a.GetOutstandingRequests()
+	outstandingB := b.GetOutstandingRequests()
+	if outstandingA != outstandingB {
+		if outstandingA < outstandingB {
+			return a
+		}
+		return b
+	}
+	latencyA := a.GetLatencyTracker().GetP90Latency()
+	latencyB := b.GetLatencyTracker().GetP90Latency()
+	// Prefer nodes without measurements, so they can be measured
+	if math.IsNaN(latencyB) || (!math.IsNaN(latencyA) && latencyB < latencyA) {
+		return b
+	}
+	return a
+}
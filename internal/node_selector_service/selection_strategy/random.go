@@ -0,0 +1,23 @@
+package selection_strategy
+
+import (
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"github.com/pokt-network/gateway-server/pkg/common"
+)
+
+const RandomStrategyName = "random"
+
+// RandomStrategy picks a node at random.
+type RandomStrategy struct{}
+
+func NewRandomStrategy() *RandomStrategy {
+	return &RandomStrategy{}
+}
+
+func (s *RandomStrategy) Name() string {
+	return RandomStrategyName
+}
+
+func (s *RandomStrategy) SelectNode(chainId string, nodes []*models.QosNode) (*models.QosNode, bool) {
+	return common.GetRandomElement(nodes)
+}
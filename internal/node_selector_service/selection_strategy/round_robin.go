@@ -0,0 +1,40 @@
+package selection_strategy
+
+import (
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+const RoundRobinStrategyName = "round_robin"
+
+// RoundRobinStrategy cycles through the nodes of a chain in order of their public key.
+type RoundRobinStrategy struct {
+	chainCounters sync.Map // chain id -> *atomic.Uint64
+}
+
+func NewRoundRobinStrategy() *RoundRobinStrategy {
+	return &RoundRobinStrategy{}
+}
+
+func (s *RoundRobinStrategy) Name() string {
+	return RoundRobinStrategyName
+}
+
+func (s *RoundRobinStrategy) SelectNode(chainId string, nodes []*models.QosNode) (*models.QosNode, bool) {
+	if len(nodes) == 0 {
+		return nil, false
+	}
+
+	// Node lists are built from maps, so they need to be sorted for a stable order between calls.
+	sortedNodes := make([]*models.QosNode, len(nodes))
+	copy(sortedNodes, nodes)
+	sort.Slice(sortedNodes, func(i, j int) bool {
+		return sortedNodes[i].GetPublicKey() < sortedNodes[j].GetPublicKey()
+	})
+
+	counter, _ := s.chainCounters.LoadOrStore(chainId, &atomic.Uint64{})
+	next := counter.(*atomic.Uint64).Add(1) - 1
+	return sortedNodes[next%uint64(len(sortedNodes))], true
+}
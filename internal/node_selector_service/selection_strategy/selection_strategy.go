@@ -0,0 +1,27 @@
+package selection_strategy
+
+import "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+
+// DefaultStrategy is used whenever a chain does not have a strategy configured or the configured strategy is unknown.
+const DefaultStrategy = RandomStrategyName
+
+// SelectionStrategy picks a node to send a relay to from a list of healthy candidate nodes for a chain.
+type SelectionStrategy interface {
+	Name() string
+	SelectNode(chainId string, nodes []*models.QosNode) (*models.QosNode, bool)
+}
+
+// NewSelectionStrategies returns all the built-in selection strategies keyed by name.
+func NewSelectionStrategies() map[string]SelectionStrategy {
+	strategies := map[string]SelectionStrategy{}
+	for _, strategy := range []SelectionStrategy{
+		NewRandomStrategy(),
+		NewWeightedLatencyStrategy(),
+		NewLeastOutstandingRequestsStrategy(),
+		NewPowerOfTwoChoicesStrategy(),
+		NewRoundRobinStrategy(),
+	} {
+		strategies[strategy.Name()] = strategy
+	}
+	return strategies
+}
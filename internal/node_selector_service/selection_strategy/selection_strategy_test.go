@@ -0,0 +1,120 @@
+package selection_strategy
+
+import (
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewSelectionStrategies(t *testing.T) {
+	strategies := NewSelectionStrategies()
+	for _, name := range []string{RandomStrategyName, WeightedLatencyStrategyName, LeastOutstandingRequestsStrategyName, PowerOfTwoChoicesStrategyName, RoundRobinStrategyName} {
+		strategy, ok := strategies[name]
+		assert.True(t, ok, name)
+		assert.Equal(t, name, strategy.Name())
+	}
+	assert.Contains(t, strategies, DefaultStrategy)
+}
+
+func TestSelectNodeEmpty(t *testing.T) {
+	for name, strategy := range NewSelectionStrategies() {
+		t.Run(name, func(t *testing.T) {
+			node, ok := strategy.SelectNode("1234", nil)
+			assert.False(t, ok)
+			assert.Nil(t, node)
+		})
+	}
+}
+
+func TestLeastOutstandingRequestsStrategy(t *testing.T) {
+	strategy := NewLeastOutstandingRequestsStrategy()
+	nodes := []*models.QosNode{
+		models.NewTestQosNode("busy", "1234", models.WithOutstandingRequests(5)),
+		models.NewTestQosNode("idle", "1234"),
+		models.NewTestQosNode("busier", "1234", models.WithOutstandingRequests(10)),
+	}
+	for i := 0; i < 10; i++ {
+		node, ok := strategy.SelectNode("1234", nodes)
+		assert.True(t, ok)
+		assert.Equal(t, "idle", node.GetPublicKey())
+	}
+}
+
+func TestPowerOfTwoChoicesStrategy(t *testing.T) {
+	strategy := NewPowerOfTwoChoicesStrategy()
+
+	tests := []struct {
+		name        string
+		nodes       []*models.QosNode
+		expectedKey string
+	}{
+		{
+			name:        "SingleNode",
+			nodes:       []*models.QosNode{models.NewTestQosNode("only", "1234", models.WithOutstandingRequests(3))},
+			expectedKey: "only",
+		},
+		{
+			name: "FewerOutstandingRequests",
+			nodes: []*models.QosNode{
+				models.NewTestQosNode("busy", "1234", models.WithOutstandingRequests(5)),
+				models.NewTestQosNode("idle", "1234"),
+			},
+			expectedKey: "idle",
+		},
+		{
+			name: "TieBrokenByLatency",
+			nodes: []*models.QosNode{
+				models.NewTestQosNode("slow", "1234", models.WithOutstandingRequests(1), models.WithLatencies(500)),
+				models.NewTestQosNode("fast", "1234", models.WithOutstandingRequests(1), models.WithLatencies(50)),
+			},
+			expectedKey: "fast",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				node, ok := strategy.SelectNode("1234", tt.nodes)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedKey, node.GetPublicKey())
+			}
+		})
+	}
+}
+
+func TestRoundRobinStrategy(t *testing.T) {
+	strategy := NewRoundRobinStrategy()
+	nodes := []*models.QosNode{
+		models.NewTestQosNode("c", "1234"),
+		models.NewTestQosNode("a", "1234"),
+		models.NewTestQosNode("b", "1234"),
+	}
+
+	var selected []string
+	for i := 0; i < 6; i++ {
+		node, ok := strategy.SelectNode("1234", nodes)
+		assert.True(t, ok)
+		selected = append(selected, node.GetPublicKey())
+	}
+	assert.Equal(t, []string{"a", "b", "c", "a", "b", "c"}, selected)
+
+	// Other chains keep their own position
+	node, _ := strategy.SelectNode("5678", nodes)
+	assert.Equal(t, "a", node.GetPublicKey())
+}
+
+func TestWeightedLatencyStrategy(t *testing.T) {
+	strategy := NewWeightedLatencyStrategy()
+	nodes := []*models.QosNode{
+		models.NewTestQosNode("fast", "1234", models.WithLatencies(10)),
+		models.NewTestQosNode("slow", "1234", models.WithLatencies(10000)),
+	}
+
+	selections := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		node, ok := strategy.SelectNode("1234", nodes)
+		assert.True(t, ok)
+		selections[node.GetPublicKey()]++
+	}
+	// fast node is 1000x faster, so it should receive the vast majority of relays
+	assert.Greater(t, selections["fast"], selections["slow"])
+}
@@ -0,0 +1,64 @@
+package selection_strategy
+
+import (
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"math"
+	"math/rand"
+)
+
+const WeightedLatencyStrategyName = "weighted_latency"
+
+// minimum latency used for weighting to prevent division by zero on nodes that respond instantly
+const minWeightedLatencyMs = 1.0
+
+// WeightedLatencyStrategy picks a node at random weighted by the inverse of its P90 latency,
+// so a node that is twice as fast receives twice as many relays.
+type WeightedLatencyStrategy struct{}
+
+func NewWeightedLatencyStrategy() *WeightedLatencyStrategy {
+	return &WeightedLatencyStrategy{}
+}
+
+func (s *WeightedLatencyStrategy) Name() string {
+	return WeightedLatencyStrategyName
+}
+
+func (s *WeightedLatencyStrategy) SelectNode(chainId string, nodes []*models.QosNode) (*models.QosNode, bool) {
+	if len(nodes) == 0 {
+		return nil, false
+	}
+
+	weights := make([]float64, len(nodes))
+	var maxWeight float64
+	for i, node := range nodes {
+		p90Latency := node.GetLatencyTracker().GetP90Latency()
+		// Nodes without measurements are weighted once the highest weight of the measured nodes is known
+		if math.IsNaN(p90Latency) {
+			weights[i] = math.NaN()
+			continue
+		}
+		weights[i] = 1 / math.Max(p90Latency, minWeightedLatencyMs)
+		maxWeight = math.Max(maxWeight, weights[i])
+	}
+
+	// Nodes without measurements receive the highest known weight, so they can be measured
+	if maxWeight == 0 {
+		maxWeight = 1
+	}
+	var totalWeight float64
+	for i := range weights {
+		if math.IsNaN(weights[i]) {
+			weights[i] = maxWeight
+		}
+		totalWeight += weights[i]
+	}
+
+	target := rand.Float64() * totalWeight
+	for i, weight := range weights {
+		target -= weight
+		if target < 0 {
+			return nodes[i], true
+		}
+	}
+	return nodes[len(nodes)-1], true
+}
@@ -0,0 +1,195 @@
+package relay_rate_limiter
+
+import (
+	"context"
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/pkg/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+const (
+	relayRateLimitsUpdateInterval = time.Minute * 1
+	// Usage is written in batches, so gateways sharing the database can exceed a quota by the relays of one interval
+	relayQuotaUsageSyncInterval = time.Second * 10
+)
+
+const (
+	dayPeriod   = "day"
+	monthPeriod = "month"
+)
+
+var (
+	counterRelayRateLimit *prometheus.CounterVec
+)
+
+func init() {
+	counterRelayRateLimit = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_rate_limit_counter",
+			Help: "Relays checked against the api key rate limits and quotas, by result",
+		},
+		[]string{"result"},
+	)
+	prometheus.MustRegister(counterRelayRateLimit)
+}
+
+type quotaUsageKey struct {
+	apiKeyHash  string
+	period      string
+	periodStart time.Time
+}
+
+// CachedRelayRateLimiter enforces the relay_rate_limits of api keys in memory, the quota usage is periodically
+// written to and reloaded from the database so that usage is shared between gateways and survives restarts.
+type CachedRelayRateLimiter struct {
+	dbQuery      db_query.Querier
+	clients      map[string]*clientLimiter // api key hash > limiter
+	pendingUsage map[quotaUsageKey]int     // relays not written to the database yet
+	lock         sync.Mutex
+	logger       *zap.Logger
+}
+
+func NewCachedRelayRateLimiter(dbQuery db_query.Querier, logger *zap.Logger) *CachedRelayRateLimiter {
+	relayRateLimiter := &CachedRelayRateLimiter{dbQuery: dbQuery, clients: map[string]*clientLimiter{}, pendingUsage: map[quotaUsageKey]int{}, logger: logger}
+	if err := relayRateLimiter.updateRateLimits(); err != nil {
+		relayRateLimiter.logger.Sugar().Warnw("Failed to retrieve relay rate limits on startup", "err", err)
+	}
+	if err := relayRateLimiter.syncQuotaUsage(); err != nil {
+		relayRateLimiter.logger.Sugar().Warnw("Failed to retrieve relay quota usage on startup", "err", err)
+	}
+	relayRateLimiter.startCacheUpdater()
+	return relayRateLimiter
+}
+
+func (r *CachedRelayRateLimiter) Allow(apiKey string) (RelayLimitResult, time.Duration) {
+	apiKeyHash := common.Sha256HashHex(apiKey)
+	now := time.Now().UTC()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	client, ok := r.clients[apiKeyHash]
+	if !ok {
+		counterRelayRateLimit.WithLabelValues(string(RelayUnknownAPIKey)).Inc()
+		return RelayUnknownAPIKey, 0
+	}
+	result, retryAfter := client.allow(now)
+	if result == RelayAllowed {
+		r.pendingUsage[quotaUsageKey{apiKeyHash: apiKeyHash, period: dayPeriod, periodStart: client.dayStart}]++
+		r.pendingUsage[quotaUsageKey{apiKeyHash: apiKeyHash, period: monthPeriod, periodStart: client.monthStart}]++
+	}
+	counterRelayRateLimit.WithLabelValues(string(result)).Inc()
+	return result, retryAfter
+}
+
+func (r *CachedRelayRateLimiter) updateRateLimits() error {
+	rateLimits, err := r.dbQuery.GetRelayRateLimits(context.Background())
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	clients := map[string]*clientLimiter{}
+	for _, row := range rateLimits {
+		limits := newRelayLimits(row.RequestsPerSecond, row.Burst, row.DailyRelayQuota, row.MonthlyRelayQuota)
+		// Existing api keys keep their token bucket and usage
+		if client, ok := r.clients[row.ApiKeyHash.String]; ok {
+			client.setLimits(limits)
+			clients[row.ApiKeyHash.String] = client
+		} else {
+			clients[row.ApiKeyHash.String] = newClientLimiter(limits, now)
+		}
+	}
+	r.clients = clients
+	return nil
+}
+
+// syncQuotaUsage - writes the pending usage to the database, then reloads the usage of the current periods which
+// includes relays served by other gateways.
+func (r *CachedRelayRateLimiter) syncQuotaUsage() error {
+	r.lock.Lock()
+	pendingUsage := r.pendingUsage
+	r.pendingUsage = map[quotaUsageKey]int{}
+	r.lock.Unlock()
+
+	failedUsage := map[quotaUsageKey]int{}
+	var incrementErr error
+	for key, relayCount := range pendingUsage {
+		_, err := r.dbQuery.IncrementRelayQuotaUsage(context.Background(), db_query.IncrementRelayQuotaUsageParams{
+			ApiKeyHash:  key.apiKeyHash,
+			Period:      key.period,
+			PeriodStart: pgtype.Timestamp{Time: key.periodStart, Status: pgtype.Present},
+			RelayCount:  relayCount,
+		})
+		if err != nil {
+			failedUsage[key] = relayCount
+			incrementErr = err
+		}
+	}
+	if len(failedUsage) > 0 {
+		// Retried on the next sync, reloading now would drop the usage that failed to be written
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		for key, relayCount := range failedUsage {
+			r.pendingUsage[key] += relayCount
+		}
+		return incrementErr
+	}
+
+	now := time.Now().UTC()
+	dayStart, monthStart := getDayStart(now), getMonthStart(now)
+	quotaUsage, err := r.dbQuery.GetRelayQuotaUsage(context.Background(), pgtype.Timestamp{Time: dayStart, Status: pgtype.Present}, pgtype.Timestamp{Time: monthStart, Status: pgtype.Present})
+	if err != nil {
+		return err
+	}
+	usage := map[quotaUsageKey]int{}
+	for _, row := range quotaUsage {
+		if row.RelayCount == nil {
+			continue
+		}
+		key := quotaUsageKey{apiKeyHash: row.ApiKeyHash.String, period: row.Period.String, periodStart: dayStart}
+		if key.period == monthPeriod {
+			key.periodStart = monthStart
+		}
+		usage[key] = *row.RelayCount
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for apiKeyHash, client := range r.clients {
+		client.rollPeriods(now)
+		dayKey := quotaUsageKey{apiKeyHash: apiKeyHash, period: dayPeriod, periodStart: client.dayStart}
+		monthKey := quotaUsageKey{apiKeyHash: apiKeyHash, period: monthPeriod, periodStart: client.monthStart}
+		// Relays allowed while syncing are still pending
+		client.dailyUsage = usage[dayKey] + r.pendingUsage[dayKey]
+		client.monthlyUsage = usage[monthKey] + r.pendingUsage[monthKey]
+	}
+	return nil
+}
+
+// startCacheUpdater starts goroutines to periodically update the rate limits and sync the quota usage.
+func (r *CachedRelayRateLimiter) startCacheUpdater() {
+	rateLimitsTicker := time.Tick(relayRateLimitsUpdateInterval)
+	quotaUsageTicker := time.Tick(relayQuotaUsageSyncInterval)
+	go func() {
+		for {
+			select {
+			case <-rateLimitsTicker:
+				err := r.updateRateLimits()
+				if err != nil {
+					r.logger.Sugar().Warnw("failed to update relay rate limits", "err", err)
+				}
+			case <-quotaUsageTicker:
+				err := r.syncQuotaUsage()
+				if err != nil {
+					r.logger.Sugar().Warnw("failed to sync relay quota usage", "err", err)
+				}
+			}
+		}
+	}()
+}
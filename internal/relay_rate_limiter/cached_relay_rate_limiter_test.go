@@ -0,0 +1,101 @@
+package relay_rate_limiter
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	db_query_mock "github.com/pokt-network/gateway-server/mocks/db_query"
+	"github.com/pokt-network/gateway-server/pkg/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+type RelayRateLimiterTestSuite struct {
+	suite.Suite
+	mockQuerier      *db_query_mock.Querier
+	relayRateLimiter *CachedRelayRateLimiter
+	// quota usage of the database, shared by every gateway
+	usage        map[quotaUsageKey]int
+	incrementErr error
+}
+
+func (suite *RelayRateLimiterTestSuite) SetupTest() {
+	dailyQuota := 3
+	suite.usage = map[quotaUsageKey]int{}
+	suite.incrementErr = nil
+	suite.mockQuerier = new(db_query_mock.Querier)
+	suite.mockQuerier.EXPECT().GetRelayRateLimits(mock.Anything).Return([]db_query.GetRelayRateLimitsRow{
+		{ApiKeyHash: pgtype.Varchar{String: common.Sha256HashHex("key"), Status: pgtype.Present}, DailyRelayQuota: &dailyQuota},
+	}, nil)
+	suite.mockQuerier.EXPECT().GetRelayQuotaUsage(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(suite.getRelayQuotaUsage).Maybe()
+	suite.mockQuerier.EXPECT().IncrementRelayQuotaUsage(mock.Anything, mock.Anything).RunAndReturn(suite.incrementRelayQuotaUsage).Maybe()
+	suite.relayRateLimiter = &CachedRelayRateLimiter{dbQuery: suite.mockQuerier, clients: map[string]*clientLimiter{}, pendingUsage: map[quotaUsageKey]int{}, logger: zap.NewNop()}
+	suite.Require().NoError(suite.relayRateLimiter.updateRateLimits())
+}
+
+func (suite *RelayRateLimiterTestSuite) getRelayQuotaUsage(ctx context.Context, dayStart pgtype.Timestamp, monthStart pgtype.Timestamp) ([]db_query.GetRelayQuotaUsageRow, error) {
+	var rows []db_query.GetRelayQuotaUsageRow
+	for key, relayCount := range suite.usage {
+		if (key.period == dayPeriod && key.periodStart.Equal(dayStart.Time)) || (key.period == monthPeriod && key.periodStart.Equal(monthStart.Time)) {
+			relayCount := relayCount
+			rows = append(rows, db_query.GetRelayQuotaUsageRow{
+				ApiKeyHash: pgtype.Varchar{String: key.apiKeyHash, Status: pgtype.Present},
+				Period:     pgtype.Varchar{String: key.period, Status: pgtype.Present},
+				RelayCount: &relayCount,
+			})
+		}
+	}
+	return rows, nil
+}
+
+func (suite *RelayRateLimiterTestSuite) incrementRelayQuotaUsage(ctx context.Context, params db_query.IncrementRelayQuotaUsageParams) (pgconn.CommandTag, error) {
+	if suite.incrementErr != nil {
+		return nil, suite.incrementErr
+	}
+	suite.usage[quotaUsageKey{apiKeyHash: params.ApiKeyHash, period: params.Period, periodStart: params.PeriodStart.Time}] += params.RelayCount
+	return nil, nil
+}
+
+func (suite *RelayRateLimiterTestSuite) TestUnknownAPIKey() {
+	result, _ := suite.relayRateLimiter.Allow("unknown")
+	suite.Equal(RelayUnknownAPIKey, result)
+}
+
+func (suite *RelayRateLimiterTestSuite) TestQuotaIsSharedThroughDatabase() {
+	result, _ := suite.relayRateLimiter.Allow("key")
+	suite.Equal(RelayAllowed, result)
+	suite.Require().NoError(suite.relayRateLimiter.syncQuotaUsage())
+
+	// Another gateway served a relay of the same api key in the meantime
+	dayStart := getDayStart(time.Now())
+	suite.Equal(1, suite.usage[quotaUsageKey{apiKeyHash: common.Sha256HashHex("key"), period: dayPeriod, periodStart: dayStart}])
+	suite.usage[quotaUsageKey{apiKeyHash: common.Sha256HashHex("key"), period: dayPeriod, periodStart: dayStart}]++
+	suite.Require().NoError(suite.relayRateLimiter.syncQuotaUsage())
+
+	result, _ = suite.relayRateLimiter.Allow("key")
+	suite.Equal(RelayAllowed, result)
+	result, retryAfter := suite.relayRateLimiter.Allow("key")
+	suite.Equal(RelayQuotaExceeded, result)
+	suite.Greater(retryAfter, time.Duration(0))
+	suite.LessOrEqual(retryAfter, time.Hour*24)
+}
+
+func (suite *RelayRateLimiterTestSuite) TestFailedUsageIsRetried() {
+	suite.incrementErr = errors.New("database unavailable")
+	suite.relayRateLimiter.Allow("key")
+	suite.Error(suite.relayRateLimiter.syncQuotaUsage())
+
+	suite.incrementErr = nil
+	suite.Require().NoError(suite.relayRateLimiter.syncQuotaUsage())
+	suite.Equal(1, suite.usage[quotaUsageKey{apiKeyHash: common.Sha256HashHex("key"), period: monthPeriod, periodStart: getMonthStart(time.Now())}])
+	suite.Equal(1, suite.relayRateLimiter.clients[common.Sha256HashHex("key")].dailyUsage)
+}
+
+func TestRelayRateLimiterTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayRateLimiterTestSuite))
+}
@@ -0,0 +1,107 @@
+package relay_rate_limiter
+
+import (
+	"math"
+	"time"
+)
+
+// relayLimits of an api key, zero values are unlimited.
+type relayLimits struct {
+	requestsPerSecond float64
+	burst             float64
+	dailyQuota        int
+	monthlyQuota      int
+}
+
+// clientLimiter is the token bucket and quota usage of an api key. Periods are in UTC.
+type clientLimiter struct {
+	limits       relayLimits
+	tokens       float64
+	lastRefill   time.Time
+	dayStart     time.Time
+	monthStart   time.Time
+	dailyUsage   int
+	monthlyUsage int
+}
+
+func newRelayLimits(requestsPerSecond *int32, burst *int32, dailyQuota *int, monthlyQuota *int) relayLimits {
+	limits := relayLimits{}
+	if requestsPerSecond != nil && *requestsPerSecond > 0 {
+		limits.requestsPerSecond = float64(*requestsPerSecond)
+		// Default to one second worth of relays
+		limits.burst = math.Ceil(limits.requestsPerSecond)
+		if burst != nil && *burst > 0 {
+			limits.burst = float64(*burst)
+		}
+	}
+	if dailyQuota != nil && *dailyQuota > 0 {
+		limits.dailyQuota = *dailyQuota
+	}
+	if monthlyQuota != nil && *monthlyQuota > 0 {
+		limits.monthlyQuota = *monthlyQuota
+	}
+	return limits
+}
+
+func newClientLimiter(limits relayLimits, now time.Time) *clientLimiter {
+	return &clientLimiter{
+		limits:     limits,
+		tokens:     limits.burst,
+		lastRefill: now,
+		dayStart:   getDayStart(now),
+		monthStart: getMonthStart(now),
+	}
+}
+
+// setLimits - updates the limits while keeping the current usage.
+func (c *clientLimiter) setLimits(limits relayLimits) {
+	c.limits = limits
+	c.tokens = min(c.tokens, limits.burst)
+}
+
+// allow - consumes a relay if the quotas and token bucket allow it, otherwise returns how long to wait before retrying.
+func (c *clientLimiter) allow(now time.Time) (RelayLimitResult, time.Duration) {
+	c.rollPeriods(now)
+	if c.limits.monthlyQuota > 0 && c.monthlyUsage >= c.limits.monthlyQuota {
+		return RelayQuotaExceeded, c.monthStart.AddDate(0, 1, 0).Sub(now)
+	}
+	if c.limits.dailyQuota > 0 && c.dailyUsage >= c.limits.dailyQuota {
+		return RelayQuotaExceeded, c.dayStart.AddDate(0, 0, 1).Sub(now)
+	}
+	if c.limits.requestsPerSecond > 0 {
+		elapsed := now.Sub(c.lastRefill)
+		if elapsed > 0 {
+			c.tokens = min(c.limits.burst, c.tokens+elapsed.Seconds()*c.limits.requestsPerSecond)
+			c.lastRefill = now
+		}
+		if c.tokens < 1 {
+			return RelayRateLimited, time.Duration((1 - c.tokens) / c.limits.requestsPerSecond * float64(time.Second))
+		}
+		c.tokens--
+	}
+	c.dailyUsage++
+	c.monthlyUsage++
+	return RelayAllowed, 0
+}
+
+// rollPeriods - resets the usage once the day or month is over.
+func (c *clientLimiter) rollPeriods(now time.Time) {
+	if dayStart := getDayStart(now); !dayStart.Equal(c.dayStart) {
+		c.dayStart = dayStart
+		c.dailyUsage = 0
+	}
+	if monthStart := getMonthStart(now); !monthStart.Equal(c.monthStart) {
+		c.monthStart = monthStart
+		c.monthlyUsage = 0
+	}
+}
+
+func getDayStart(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func getMonthStart(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,59 @@
+package relay_rate_limiter
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func intPointer[T int | int32](value T) *T {
+	return &value
+}
+
+func TestClientLimiterTokenBucket(t *testing.T) {
+	now := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	limiter := newClientLimiter(newRelayLimits(intPointer[int32](2), intPointer[int32](3), nil, nil), now)
+
+	// The burst is available right away
+	for i := 0; i < 3; i++ {
+		result, _ := limiter.allow(now)
+		assert.Equal(t, RelayAllowed, result)
+	}
+	result, retryAfter := limiter.allow(now)
+	assert.Equal(t, RelayRateLimited, result)
+	assert.Equal(t, time.Millisecond*500, retryAfter)
+
+	// Tokens are refilled at the requests per second
+	result, _ = limiter.allow(now.Add(time.Millisecond * 500))
+	assert.Equal(t, RelayAllowed, result)
+	result, _ = limiter.allow(now.Add(time.Millisecond * 500))
+	assert.Equal(t, RelayRateLimited, result)
+}
+
+func TestClientLimiterDefaultBurst(t *testing.T) {
+	limits := newRelayLimits(intPointer[int32](5), nil, nil, nil)
+	assert.Equal(t, float64(5), limits.burst)
+
+	limits = newRelayLimits(nil, intPointer[int32](5), nil, nil)
+	assert.Equal(t, relayLimits{}, limits)
+}
+
+func TestClientLimiterQuotas(t *testing.T) {
+	now := time.Date(2024, 1, 10, 23, 0, 0, 0, time.UTC)
+	limiter := newClientLimiter(newRelayLimits(nil, nil, intPointer(1), intPointer(2)), now)
+
+	result, _ := limiter.allow(now)
+	assert.Equal(t, RelayAllowed, result)
+	result, retryAfter := limiter.allow(now)
+	assert.Equal(t, RelayQuotaExceeded, result)
+	assert.Equal(t, time.Hour, retryAfter)
+
+	// The daily quota is reset the next day, but the monthly quota is only reset the next month
+	now = now.Add(time.Hour)
+	result, _ = limiter.allow(now)
+	assert.Equal(t, RelayAllowed, result)
+	now = now.Add(time.Hour * 24)
+	result, retryAfter = limiter.allow(now)
+	assert.Equal(t, RelayQuotaExceeded, result)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC).Sub(now), retryAfter)
+}
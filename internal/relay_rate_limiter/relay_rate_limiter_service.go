@@ -0,0 +1,17 @@
+package relay_rate_limiter
+
+import "time"
+
+type RelayLimitResult string
+
+const (
+	RelayAllowed       RelayLimitResult = "allowed"
+	RelayUnknownAPIKey RelayLimitResult = "unknown_api_key"
+	RelayRateLimited   RelayLimitResult = "rate_limited"
+	RelayQuotaExceeded RelayLimitResult = "quota_exceeded"
+)
+
+type RelayRateLimiterService interface {
+	// Allow - consumes a relay of the api key, returning how long the caller should wait before retrying if the relay is not allowed.
+	Allow(apiKey string) (RelayLimitResult, time.Duration)
+}
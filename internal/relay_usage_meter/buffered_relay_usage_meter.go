@@ -0,0 +1,147 @@
+package relay_usage_meter
+
+import (
+	"context"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+const (
+	relayUsageFlushInterval = time.Second * 30
+)
+
+type usageKey struct {
+	callerId string
+	chainId  string
+	hour     time.Time
+}
+
+type usageCounts struct {
+	successfulRelays int
+	failedRelays     int
+	altruistRelays   int
+	requestBytes     int
+	responseBytes    int
+}
+
+func (c *usageCounts) add(other *usageCounts) {
+	c.successfulRelays += other.successfulRelays
+	c.failedRelays += other.failedRelays
+	c.altruistRelays += other.altruistRelays
+	c.requestBytes += other.requestBytes
+	c.responseBytes += other.responseBytes
+}
+
+// BufferedRelayUsageMeter aggregates relay usage by caller, chain and hour in memory, and periodically flushes
+// the aggregates to the database in a single statement so that nothing is written per relay.
+type BufferedRelayUsageMeter struct {
+	dbQuery   db_query.Querier
+	usage     map[usageKey]*usageCounts
+	usageLock sync.Mutex
+	flushLock sync.Mutex
+	stop      chan struct{}
+	stopped   chan struct{}
+	logger    *zap.Logger
+}
+
+func NewBufferedRelayUsageMeter(dbQuery db_query.Querier, logger *zap.Logger) *BufferedRelayUsageMeter {
+	relayUsageMeter := &BufferedRelayUsageMeter{dbQuery: dbQuery, usage: map[usageKey]*usageCounts{}, stop: make(chan struct{}), stopped: make(chan struct{}), logger: logger}
+	relayUsageMeter.startFlusher()
+	return relayUsageMeter
+}
+
+func (m *BufferedRelayUsageMeter) RecordRelay(usage RelayUsage) {
+	key := usageKey{callerId: usage.CallerId, chainId: usage.ChainId, hour: time.Now().UTC().Truncate(time.Hour)}
+
+	m.usageLock.Lock()
+	defer m.usageLock.Unlock()
+	counts, ok := m.usage[key]
+	if !ok {
+		counts = &usageCounts{}
+		m.usage[key] = counts
+	}
+	if usage.Success {
+		counts.successfulRelays++
+	} else {
+		counts.failedRelays++
+	}
+	if usage.Altruist {
+		counts.altruistRelays++
+	}
+	counts.requestBytes += usage.RequestBytes
+	counts.responseBytes += usage.ResponseBytes
+}
+
+// Flush - writes the aggregated usage to the database. Usage that fails to be written is kept for the next flush.
+func (m *BufferedRelayUsageMeter) Flush() error {
+	m.flushLock.Lock()
+	defer m.flushLock.Unlock()
+
+	m.usageLock.Lock()
+	usage := m.usage
+	m.usage = map[usageKey]*usageCounts{}
+	m.usageLock.Unlock()
+
+	if len(usage) == 0 {
+		return nil
+	}
+
+	params := db_query.IncrementRelayUsageParams{}
+	hours := make([]time.Time, 0, len(usage))
+	for key, counts := range usage {
+		params.CallerIds = append(params.CallerIds, key.callerId)
+		params.ChainIds = append(params.ChainIds, key.chainId)
+		hours = append(hours, key.hour)
+		params.SuccessfulRelays = append(params.SuccessfulRelays, counts.successfulRelays)
+		params.FailedRelays = append(params.FailedRelays, counts.failedRelays)
+		params.AltruistRelays = append(params.AltruistRelays, counts.altruistRelays)
+		params.RequestBytes = append(params.RequestBytes, counts.requestBytes)
+		params.ResponseBytes = append(params.ResponseBytes, counts.responseBytes)
+	}
+	err := params.Hours.Set(hours)
+	if err == nil {
+		_, err = m.dbQuery.IncrementRelayUsage(context.Background(), params)
+	}
+	if err != nil {
+		m.usageLock.Lock()
+		defer m.usageLock.Unlock()
+		for key, counts := range usage {
+			if current, ok := m.usage[key]; ok {
+				current.add(counts)
+			} else {
+				m.usage[key] = counts
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// Stop - stops the periodic flushes and flushes the remaining usage, called once relays are no longer served.
+func (m *BufferedRelayUsageMeter) Stop() error {
+	close(m.stop)
+	<-m.stopped
+	return m.Flush()
+}
+
+// startFlusher starts a goroutine to periodically flush the aggregated usage.
+func (m *BufferedRelayUsageMeter) startFlusher() {
+	ticker := time.NewTicker(relayUsageFlushInterval)
+	go func() {
+		defer close(m.stopped)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.stop:
+				return
+			case <-ticker.C:
+				err := m.Flush()
+				if err != nil {
+					m.logger.Sugar().Warnw("failed to flush relay usage", "err", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,81 @@
+package relay_usage_meter
+
+import (
+	"context"
+	"errors"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	db_query_mock "github.com/pokt-network/gateway-server/mocks/db_query"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"testing"
+)
+
+type RelayUsageMeterTestSuite struct {
+	suite.Suite
+	mockQuerier     *db_query_mock.Querier
+	relayUsageMeter *BufferedRelayUsageMeter
+}
+
+func (suite *RelayUsageMeterTestSuite) SetupTest() {
+	suite.mockQuerier = new(db_query_mock.Querier)
+	suite.relayUsageMeter = &BufferedRelayUsageMeter{dbQuery: suite.mockQuerier, usage: map[usageKey]*usageCounts{}, logger: zap.NewNop()}
+}
+
+func (suite *RelayUsageMeterTestSuite) TearDownTest() {
+	suite.mockQuerier.AssertExpectations(suite.T())
+}
+
+// expectWrite - expects a single relay usage write, the written usage is sent to the returned channel.
+func (suite *RelayUsageMeterTestSuite) expectWrite() chan db_query.IncrementRelayUsageParams {
+	writes := make(chan db_query.IncrementRelayUsageParams, 1)
+	suite.mockQuerier.EXPECT().IncrementRelayUsage(mock.Anything, mock.Anything).Run(func(ctx context.Context, params db_query.IncrementRelayUsageParams) {
+		writes <- params
+	}).Return(nil, nil).Once()
+	return writes
+}
+
+func (suite *RelayUsageMeterTestSuite) TestRelaysAreAggregated() {
+	writes := suite.expectWrite()
+	suite.relayUsageMeter.RecordRelay(RelayUsage{CallerId: "caller", ChainId: "0021", Success: true, RequestBytes: 10, ResponseBytes: 100})
+	suite.relayUsageMeter.RecordRelay(RelayUsage{CallerId: "caller", ChainId: "0021", Success: true, Altruist: true, RequestBytes: 10, ResponseBytes: 100})
+	suite.relayUsageMeter.RecordRelay(RelayUsage{CallerId: "caller", ChainId: "0021", Success: false, RequestBytes: 10})
+
+	suite.Require().NoError(suite.relayUsageMeter.Flush())
+	write := <-writes
+	suite.Equal([]string{"caller"}, write.CallerIds)
+	suite.Equal([]string{"0021"}, write.ChainIds)
+	suite.Equal([]int{2}, write.SuccessfulRelays)
+	suite.Equal([]int{1}, write.FailedRelays)
+	suite.Equal([]int{1}, write.AltruistRelays)
+	suite.Equal([]int{30}, write.RequestBytes)
+	suite.Equal([]int{200}, write.ResponseBytes)
+	suite.Len(write.Hours.Elements, 1)
+
+	// Nothing is written without new relays
+	suite.Require().NoError(suite.relayUsageMeter.Flush())
+}
+
+func (suite *RelayUsageMeterTestSuite) TestFailedFlushIsRetried() {
+	suite.mockQuerier.EXPECT().IncrementRelayUsage(mock.Anything, mock.Anything).Return(nil, errors.New("database unavailable")).Once()
+	suite.relayUsageMeter.RecordRelay(RelayUsage{CallerId: "caller", ChainId: "0021", Success: true})
+	suite.Error(suite.relayUsageMeter.Flush())
+
+	writes := suite.expectWrite()
+	suite.relayUsageMeter.RecordRelay(RelayUsage{CallerId: "caller", ChainId: "0021", Success: true})
+	suite.Require().NoError(suite.relayUsageMeter.Flush())
+	suite.Equal([]int{2}, (<-writes).SuccessfulRelays)
+}
+
+func (suite *RelayUsageMeterTestSuite) TestStopFlushesUsage() {
+	writes := suite.expectWrite()
+	relayUsageMeter := NewBufferedRelayUsageMeter(suite.mockQuerier, zap.NewNop())
+	relayUsageMeter.RecordRelay(RelayUsage{CallerId: "caller", ChainId: "0021", Success: true})
+
+	suite.Require().NoError(relayUsageMeter.Stop())
+	suite.Equal([]int{1}, (<-writes).SuccessfulRelays)
+}
+
+func TestRelayUsageMeterTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayUsageMeterTestSuite))
+}
@@ -0,0 +1,15 @@
+package relay_usage_meter
+
+// RelayUsage is a single relay sent by a caller, such as a gateway endpoint.
+type RelayUsage struct {
+	CallerId      string
+	ChainId       string
+	Success       bool
+	Altruist      bool
+	RequestBytes  int
+	ResponseBytes int
+}
+
+type RelayUsageMeterService interface {
+	RecordRelay(usage RelayUsage)
+}
@@ -0,0 +1,160 @@
+package relayer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/pokt-network/gateway-server/internal/altruist_circuit_breaker"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"sync"
+)
+
+const (
+	// BatchRelayModePassthrough relays a JSON-RPC batch as-is to a single node
+	BatchRelayModePassthrough = "passthrough"
+	// BatchRelayModeSplit splits a JSON-RPC batch into individual relays that are sent concurrently
+	BatchRelayModeSplit = "split"
+	// maximum amount of calls from a single batch that are relayed at the same time
+	maxConcurrentBatchRelays = 10
+	// JSON-RPC error codes returned for calls inside a batch
+	jsonRpcInvalidRequestCode = -32600
+	jsonRpcInternalErrorCode  = -32603
+	// implementation defined server errors, for calls that could not be relayed
+	jsonRpcUnavailableCode = -32000
+	jsonRpcTimeoutCode     = -32001
+)
+
+type jsonRpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRpcErrorResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id"`
+	Error   jsonRpcError    `json:"error"`
+}
+
+// jsonRpcBatchCall only decodes the fields of a JSON-RPC call needed to build a response for it
+type jsonRpcBatchCall struct {
+	Id json.RawMessage `json:"id"`
+}
+
+// parseJsonRpcBatch - returns the calls of a JSON-RPC batch and whether the payload is a batch.
+func parseJsonRpcBatch(payload *models.Payload) ([]json.RawMessage, bool) {
+	if payload == nil {
+		return nil, false
+	}
+	data := bytes.TrimSpace([]byte(payload.Data))
+	if len(data) == 0 || data[0] != '[' {
+		return nil, false
+	}
+	var batch []json.RawMessage
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return nil, false
+	}
+	return batch, true
+}
+
+// sendSplitBatchRelay - relays every call of a JSON-RPC batch individually, so calls can be served by different nodes.
+// Responses are returned in the order of the batch. A call that fails is answered with a JSON-RPC error using the call's id
+// instead of failing the whole batch. Notifications (calls without an id) are relayed but are not part of the response,
+// a batch of only notifications has an empty response.
+func (r *Relayer) sendSplitBatchRelay(req *models.SendRelayRequest, batch []json.RawMessage) (*models.SendRelayResponse, error) {
+	if len(batch) == 0 {
+		// An empty batch is an invalid request as a whole
+		return newJsonRpcErrorRelayResponse(nil, jsonRpcInvalidRequestCode, "empty batch")
+	}
+
+	responses := make([]json.RawMessage, len(batch))
+	isNotification := make([]bool, len(batch))
+	semaphore := make(chan struct{}, maxConcurrentBatchRelays)
+	var wg sync.WaitGroup
+	for i, call := range batch {
+		var batchCall jsonRpcBatchCall
+		if err := json.Unmarshal(call, &batchCall); err != nil {
+			responses[i] = newJsonRpcErrorResponse(nil, jsonRpcInvalidRequestCode, "invalid request")
+			continue
+		}
+		isNotification[i] = batchCall.Id == nil
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, call json.RawMessage, id json.RawMessage) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			responses[i] = r.sendBatchCallRelay(req, call, id)
+		}(i, call, batchCall.Id)
+	}
+	wg.Wait()
+
+	batchResponse := make([]json.RawMessage, 0, len(batch))
+	for i, response := range responses {
+		if !isNotification[i] {
+			batchResponse = append(batchResponse, response)
+		}
+	}
+	if len(batchResponse) == 0 {
+		// A batch of notifications is not answered (JSON-RPC 2.0)
+		return &models.SendRelayResponse{}, nil
+	}
+	rsp, err := json.Marshal(batchResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &models.SendRelayResponse{Response: string(rsp)}, nil
+}
+
+// sendBatchCallRelay - relays a single call of a batch and returns its response, or a JSON-RPC error if the relay failed.
+func (r *Relayer) sendBatchCallRelay(req *models.SendRelayRequest, call json.RawMessage, id json.RawMessage) json.RawMessage {
+	callPayload := *req.Payload
+	callPayload.Data = string(call)
+	callReq := *req
+	callReq.Payload = &callPayload
+
+	rsp, err := r.sendRelay(&callReq)
+	if err != nil {
+		code, message := getJsonRpcRelayError(err)
+		return newJsonRpcErrorResponse(id, code, message)
+	}
+	if !json.Valid([]byte(rsp.Response)) {
+		return newJsonRpcErrorResponse(id, jsonRpcInternalErrorCode, "invalid response from upstream")
+	}
+	return json.RawMessage(rsp.Response)
+}
+
+// getJsonRpcRelayError - maps a relay error to a generic JSON-RPC error, relay errors can contain node urls and
+// internal details that are not returned to callers.
+func getJsonRpcRelayError(err error) (int, string) {
+	if errors.Is(err, models.ErrNoNodeAvailable) || errors.Is(err, altruist_circuit_breaker.ErrCircuitOpen) {
+		return jsonRpcUnavailableCode, "no upstream available"
+	}
+	if checks.IsTimeoutError(err) {
+		return jsonRpcTimeoutCode, "upstream timed out"
+	}
+	return jsonRpcInternalErrorCode, "upstream error"
+}
+
+func newJsonRpcErrorResponse(id json.RawMessage, code int, message string) json.RawMessage {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	rsp, _ := json.Marshal(jsonRpcErrorResponse{JsonRpc: "2.0", Id: id, Error: jsonRpcError{Code: code, Message: message}})
+	return rsp
+}
+
+func newJsonRpcErrorRelayResponse(id json.RawMessage, code int, message string) (*models.SendRelayResponse, error) {
+	return &models.SendRelayResponse{Response: string(newJsonRpcErrorResponse(id, code, message))}, nil
+}
+
+// getBatchRelayMode - returns how JSON-RPC batches are relayed for the chain, defaulting to passthrough.
+func (r *Relayer) getBatchRelayMode(chainId string) string {
+	chainConfig, ok := r.chainConfigurationRegistry.GetChainConfiguration(chainId)
+	if !ok || chainConfig.BatchRelayMode.String != BatchRelayModeSplit {
+		return BatchRelayModePassthrough
+	}
+	return BatchRelayModeSplit
+}
@@ -0,0 +1,114 @@
+package relayer
+
+import (
+	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// HedgeDelayChainP50 can be configured as a chain's hedge delay to hedge after the chain's observed P50 latency
+const HedgeDelayChainP50 = "p50"
+
+type hedgedRelayResult struct {
+	response *models.SendRelayResponse
+	node     *qos_models.QosNode
+	err      error
+	hedged   bool
+}
+
+// sendHedgedNodeSelectorRelay - sends a relay to a node and, if the node has not answered within the hedge delay, sends the same relay to a second node.
+// The first successful response wins and the other relay is ignored. Every relay is still recorded in the node's latency tracker
+// and the relay latency histogram once it finishes.
+func (r *Relayer) sendHedgedNodeSelectorRelay(req *models.SendRelayRequest, attemptedNodes map[string]bool, hedgeDelay time.Duration) (*models.SendRelayResponse, *qos_models.QosNode, error) {
+	primaryNode, err := r.selectNode(req, attemptedNodes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startTime := time.Now()
+	results := make(chan *hedgedRelayResult, 2)
+	sendRelay := func(node *qos_models.QosNode, hedged bool) {
+		// Each relay needs its own request since it is populated with the node's session metadata
+		nodeReq := *req
+		rsp, err := r.sendRelayToNode(&nodeReq, node)
+		results <- &hedgedRelayResult{response: rsp, node: node, err: err, hedged: hedged}
+	}
+
+	go sendRelay(primaryNode, false)
+	inFlight := 1
+
+	hedgeTimer := time.NewTimer(hedgeDelay)
+	defer hedgeTimer.Stop()
+
+	for {
+		select {
+		case <-hedgeTimer.C:
+			hedgeNode, err := r.selectNode(req, attemptedNodes)
+			if err != nil {
+				// No other node is available, keep waiting on the primary node
+				continue
+			}
+			counterRelayHedge.WithLabelValues(req.Chain).Inc()
+			go sendRelay(hedgeNode, true)
+			inFlight++
+		case result := <-results:
+			inFlight--
+			if result.err == nil {
+				counterRelayHedgeWinner.WithLabelValues(req.Chain, strconv.FormatBool(result.hedged)).Inc()
+				if inFlight > 0 {
+					go r.recordHedgedRelayLoser(req.Chain, results, startTime)
+				}
+				return result.response, result.node, nil
+			}
+			if inFlight == 0 {
+				return nil, result.node, result.err
+			}
+			// Another relay is still in-flight, so the failed relay is not returned to the caller
+			r.recordHedgedRelayLatency(req.Chain, result, startTime)
+		}
+	}
+}
+
+// recordHedgedRelayLoser - waits for the relay that lost the race to finish and records its latency.
+func (r *Relayer) recordHedgedRelayLoser(chainId string, results chan *hedgedRelayResult, startTime time.Time) {
+	r.recordHedgedRelayLatency(chainId, <-results, startTime)
+}
+
+func (r *Relayer) recordHedgedRelayLatency(chainId string, result *hedgedRelayResult, startTime time.Time) {
+	histogramRelayRequestLatency.WithLabelValues(strconv.FormatBool(result.err == nil), "false", chainId, r.getNodeHost(result.node)).Observe(time.Since(startTime).Seconds())
+}
+
+// getRelayHedgeDelay - returns the chain's hedge delay and whether hedging is enabled for the chain.
+func (r *Relayer) getRelayHedgeDelay(chainId string) (time.Duration, bool) {
+	chainConfig, ok := r.chainConfigurationRegistry.GetChainConfiguration(chainId)
+	if !ok || chainConfig.RelayHedgeDelayDuration.String == "" {
+		return 0, false
+	}
+	if chainConfig.RelayHedgeDelayDuration.String == HedgeDelayChainP50 {
+		return r.getChainP50Latency(chainId)
+	}
+	hedgeDelay, err := time.ParseDuration(chainConfig.RelayHedgeDelayDuration.String)
+	if err != nil {
+		return 0, false
+	}
+	return hedgeDelay, true
+}
+
+// getChainP50Latency - returns the median of the P50 latency of the chain's nodes. Returns false if no node has been measured yet.
+func (r *Relayer) getChainP50Latency(chainId string) (time.Duration, bool) {
+	var p50Latencies []float64
+	for _, node := range r.sessionRegistry.GetNodesByChain(chainId) {
+		p50Latency := node.GetLatencyTracker().GetP50Latency()
+		if !math.IsNaN(p50Latency) {
+			p50Latencies = append(p50Latencies, p50Latency)
+		}
+	}
+	if len(p50Latencies) == 0 {
+		return 0, false
+	}
+	sort.Float64s(p50Latencies)
+	return time.Duration(p50Latencies[len(p50Latencies)/2] * float64(time.Millisecond)), true
+}
@@ -0,0 +1,142 @@
+package relayer
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jackc/pgtype"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service"
+	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	altruist_circuit_breaker_mock "github.com/pokt-network/gateway-server/mocks/altruist_circuit_breaker"
+	altruist_registry_mock "github.com/pokt-network/gateway-server/mocks/altruist_registry"
+	apps_registry_mock "github.com/pokt-network/gateway-server/mocks/apps_registry"
+	chain_checks_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_checks_registry"
+	chain_configurations_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_configurations_registry"
+	global_config_mock "github.com/pokt-network/gateway-server/mocks/global_config"
+	pocket_service_mock "github.com/pokt-network/gateway-server/mocks/pocket_service"
+	session_registry_mock "github.com/pokt-network/gateway-server/mocks/session_registry"
+	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	stressTestChain      = "0021"
+	stressTestNodeCount  = 10
+	stressTestDuration   = time.Millisecond * 2500
+	stressTestRelayCount = 8
+)
+
+// stressTestNodeResponse - answers the QoS checks and relays sent to a node, nodes randomly fail or lag behind so that
+// checks and relays keep changing their health.
+func stressTestNodeResponse(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
+	if rand.Intn(10) == 0 {
+		return nil, errors.New("node unavailable")
+	}
+	height := 1000 + rand.Intn(3)*100
+	switch {
+	case strings.Contains(req.Payload.Data, "eth_blockNumber"):
+		return &models.SendRelayResponse{Response: fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, height)}, nil
+	case strings.Contains(req.Payload.Data, "eth_getBlockByNumber"):
+		return &models.SendRelayResponse{Response: fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":{"hash":"0x%x"}}`, rand.Intn(2))}, nil
+	default:
+		return &models.SendRelayResponse{Response: fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, rand.Intn(2))}, nil
+	}
+}
+
+// TestQosStress - runs the QoS checks, relays and node selection against the same nodes at the same time, meant to be
+// run with -race.
+func TestQosStress(t *testing.T) {
+	tolerance, lookback, attempts := int32(100), int32(25), int32(3)
+	sampleRate := float32(0.5)
+	chainConfig := db_query.GetChainConfigurationsRow{
+		ChainID:                          pgtype.Varchar{String: stressTestChain, Status: pgtype.Present},
+		ChainFamily:                      pgtype.Varchar{String: "evm", Status: pgtype.Present},
+		HeightCheckBlockTolerance:        &tolerance,
+		DataIntegrityCheckLookbackHeight: &lookback,
+		RelayMaxAttempts:                 &attempts,
+		RelayValidationSampleRate:        &sampleRate,
+	}
+
+	session := &models.Session{SessionHeader: &models.SessionHeader{Chain: stressTestChain, SessionHeight: 1}}
+	var nodes []*qos_models.QosNode
+	for i := 0; i < stressTestNodeCount; i++ {
+		node := qos_models.NewQosNode(&models.Node{PublicKey: fmt.Sprintf("node%d", i), ServiceUrl: fmt.Sprintf("https://node%d.com", i)}, session, &models.Ed25519Account{})
+		node.SetSynced(true)
+		nodes = append(nodes, node)
+	}
+	nodeCache := ttlcache.New[qos_models.SessionChainKey, []*qos_models.QosNode]()
+	nodeCache.Set(qos_models.SessionChainKey{SessionHeight: 1, Chain: stressTestChain}, nodes, ttlcache.NoTTL)
+
+	sessionRegistry := new(session_registry_mock.SessionRegistryService)
+	sessionRegistry.EXPECT().GetNodesMap().Return(nodeCache.Items()).Maybe()
+	sessionRegistry.EXPECT().GetNodesByChain(stressTestChain).Return(nodes).Maybe()
+	chainConfiguration := new(chain_configurations_registry_mock.ChainConfigurationsService)
+	chainConfiguration.EXPECT().GetChainConfiguration(stressTestChain).Return(chainConfig, true).Maybe()
+	chainChecks := new(chain_checks_registry_mock.ChainChecksService)
+	chainChecks.EXPECT().GetChainChecks(stressTestChain).Return(nil).Maybe()
+	pocketService := new(pocket_service_mock.PocketService)
+	pocketService.EXPECT().SendRelay(mock.Anything).RunAndReturn(stressTestNodeResponse).Maybe()
+	altruistRegistry := new(altruist_registry_mock.AltruistRegistryService)
+	altruistRegistry.EXPECT().GetAltruists(stressTestChain).Return(nil).Maybe()
+	altruistCircuitBreaker := new(altruist_circuit_breaker_mock.CircuitBreakerService)
+	altruistCircuitBreaker.EXPECT().Allow(mock.Anything).Return(true).Maybe()
+	altruistCircuitBreaker.EXPECT().RecordResult(mock.Anything, mock.Anything, mock.Anything).Maybe()
+	altruistCircuitBreaker.EXPECT().Release(mock.Anything).Maybe()
+	configProvider := new(global_config_mock.GlobalConfigProvider)
+	configProvider.EXPECT().ShouldEmitServiceUrlPromMetrics().Return(false).Maybe()
+	configProvider.EXPECT().GetAltruistRequestTimeout().Return(time.Second).Maybe()
+	configProvider.EXPECT().GetPoktRPCRequestTimeout().Return(time.Second).Maybe()
+
+	nodeSelector := node_selector_service.NewNodeSelectorService(sessionRegistry, pocketService, chainConfiguration, chainChecks, zap.NewNop())
+	defer nodeSelector.Stop()
+	relayer := NewRelayer(pocketService, sessionRegistry, new(apps_registry_mock.AppsRegistryService), nodeSelector, chainConfiguration, altruistRegistry, altruistCircuitBreaker, "", configProvider, zap.NewNop())
+
+	var wg sync.WaitGroup
+	deadline := time.Now().Add(stressTestDuration)
+	for i := 0; i < stressTestRelayCount; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for time.Now().Before(deadline) {
+				// Relays fail when every node is punished, which is expected under stress
+				relayer.SendRelay(&models.SendRelayRequest{
+					Payload: &models.Payload{Data: `{"jsonrpc":"2.0","method":"eth_getBalance","params":["0x0","0x1"],"id":1}`},
+					Chain:   stressTestChain,
+				})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for time.Now().Before(deadline) {
+				nodeSelector.FindNode(stressTestChain, node_selector_service.ArchivalNodes())
+				for _, node := range nodes {
+					health := node.GetHealth()
+					// A snapshot is never torn, a timeout is always written together with its reason
+					assert.Equal(t, health.TimeoutUntil.IsZero(), health.TimeoutReason == "")
+					node.GetLatencyTracker().GetP90Latency()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	var measuredNodes, checkedNodes int
+	for _, node := range nodes {
+		if node.GetLatencyTracker().GetMeasurementCount() > 0 {
+			measuredNodes++
+		}
+		if !node.GetLastHeightCheckTime().IsZero() {
+			checkedNodes++
+		}
+	}
+	assert.NotZero(t, measuredNodes)
+	assert.NotZero(t, checkedNodes)
+}
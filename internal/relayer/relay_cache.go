@@ -0,0 +1,196 @@
+package relayer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"github.com/valyala/fasthttp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maximum amount of relay responses kept in the cache, the least recently used responses are evicted first
+	maxRelayCacheItems = 100000
+	// blocks a receipt must be below the chain height to be cached if the chain does not have a data integrity lookback configured
+	defaultReceiptFinalityDepth = 64
+	methodGetTransactionReceipt = "eth_getTransactionReceipt"
+)
+
+// jsonRpcCall only decodes the fields of a JSON-RPC call needed to cache it
+type jsonRpcCall struct {
+	Id     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// jsonRpcResult only decodes the fields of a JSON-RPC response needed to cache it
+type jsonRpcResult struct {
+	Result json.RawMessage `json:"result"`
+	Error  json.RawMessage `json:"error"`
+}
+
+// jsonRpcReceipt only decodes the fields of a transaction receipt needed to check its finality
+type jsonRpcReceipt struct {
+	BlockNumber string `json:"blockNumber"`
+}
+
+type cacheableRelay struct {
+	key    string
+	id     json.RawMessage
+	chain  string
+	method string
+	ttl    time.Duration
+}
+
+// chainRelayCacheTTLs - the parsed relay_cache_ttls of a chain, replaced once the chain's column changes
+type chainRelayCacheTTLs struct {
+	rawCacheTTLs string
+	cacheTTLs    map[string]time.Duration
+}
+
+func newRelayCache() *ttlcache.Cache[string, json.RawMessage] {
+	cache := ttlcache.New[string, json.RawMessage](
+		ttlcache.WithCapacity[string, json.RawMessage](maxRelayCacheItems),
+		ttlcache.WithDisableTouchOnHit[string, json.RawMessage](),
+	)
+	go cache.Start()
+	return cache
+}
+
+// getCacheableRelay - returns the cache key and ttl of a relay if the chain caches the relay's JSON-RPC method.
+func (r *Relayer) getCacheableRelay(req *models.SendRelayRequest) (*cacheableRelay, bool) {
+	if req.Payload == nil || (req.Payload.Method != "" && req.Payload.Method != fasthttp.MethodPost) {
+		return nil, false
+	}
+	cacheTTLs := r.getRelayCacheTTLs(req.Chain)
+	if len(cacheTTLs) == 0 {
+		return nil, false
+	}
+
+	var call jsonRpcCall
+	if err := json.Unmarshal([]byte(req.Payload.Data), &call); err != nil || call.Id == nil {
+		return nil, false
+	}
+	ttl, ok := cacheTTLs[call.Method]
+	if !ok {
+		return nil, false
+	}
+
+	// Params are hashed to keep keys small, the chain and path are included since the same method can exist on other chains
+	hash := sha256.New()
+	hash.Write([]byte(req.Chain + "\n" + req.Payload.Path + "\n" + call.Method + "\n"))
+	params := compactJson(call.Params)
+	if len(params) == 0 || string(params) == "null" {
+		// Omitted params are the same call as empty params
+		params = []byte("[]")
+	}
+	hash.Write(params)
+	return &cacheableRelay{key: hex.EncodeToString(hash.Sum(nil)), id: call.Id, chain: req.Chain, method: call.Method, ttl: ttl}, true
+}
+
+// getCachedRelay - returns the cached response of the relay, using the request's id.
+func (r *Relayer) getCachedRelay(relay *cacheableRelay) (*models.SendRelayResponse, bool) {
+	item := r.relayCache.Get(relay.key)
+	if item == nil {
+		return nil, false
+	}
+	rsp, err := json.Marshal(map[string]json.RawMessage{
+		"jsonrpc": json.RawMessage(`"2.0"`),
+		"id":      relay.id,
+		"result":  item.Value(),
+	})
+	if err != nil {
+		return nil, false
+	}
+	return &models.SendRelayResponse{Response: string(rsp)}, true
+}
+
+// cacheRelay - caches the result of a successful relay. Errors and null results, such as the receipt of a pending
+// transaction, are never cached, and receipts are only cached once their block is final.
+func (r *Relayer) cacheRelay(relay *cacheableRelay, rsp *models.SendRelayResponse) {
+	if rsp.StatusCode != 0 && rsp.StatusCode != fasthttp.StatusOK {
+		return
+	}
+	var result jsonRpcResult
+	if err := json.Unmarshal([]byte(rsp.Response), &result); err != nil {
+		return
+	}
+	if result.Error != nil || result.Result == nil || string(result.Result) == "null" {
+		return
+	}
+	if relay.method == methodGetTransactionReceipt && !r.isReceiptFinal(relay.chain, result.Result) {
+		return
+	}
+	r.relayCache.Set(relay.key, result.Result, relay.ttl)
+}
+
+// isReceiptFinal - returns whether the receipt's block is at least the chain's finality depth below the highest height
+// known by the chain's nodes. The data integrity lookback is used as the finality depth, since it is the depth the
+// checks already consider settled.
+func (r *Relayer) isReceiptFinal(chainId string, result json.RawMessage) bool {
+	var receipt jsonRpcReceipt
+	if err := json.Unmarshal(result, &receipt); err != nil {
+		return false
+	}
+	blockNumber, err := strconv.ParseUint(strings.TrimPrefix(receipt.BlockNumber, "0x"), 16, 64)
+	if err != nil {
+		return false
+	}
+	var chainHeight uint64
+	for _, node := range r.sessionRegistry.GetNodesByChain(chainId) {
+		chainHeight = max(chainHeight, node.GetLastKnownHeight())
+	}
+	return chainHeight >= blockNumber+r.getReceiptFinalityDepth(chainId)
+}
+
+func (r *Relayer) getReceiptFinalityDepth(chainId string) uint64 {
+	chainConfig, ok := r.chainConfigurationRegistry.GetChainConfiguration(chainId)
+	if !ok || chainConfig.DataIntegrityCheckLookbackHeight == nil || *chainConfig.DataIntegrityCheckLookbackHeight <= 0 {
+		return defaultReceiptFinalityDepth
+	}
+	return uint64(*chainConfig.DataIntegrityCheckLookbackHeight)
+}
+
+// getRelayCacheTTLs - returns the chain's cache ttl by JSON-RPC method, configured as a JSON object such as {"eth_chainId": "1h"}.
+func (r *Relayer) getRelayCacheTTLs(chainId string) map[string]time.Duration {
+	chainConfig, ok := r.chainConfigurationRegistry.GetChainConfiguration(chainId)
+	if !ok || chainConfig.RelayCacheTtls.String == "" {
+		return nil
+	}
+	// Parsed configurations are kept since the column is read on every relay
+	if cached, ok := r.relayCacheTTLs.Load(chainId); ok && cached.(*chainRelayCacheTTLs).rawCacheTTLs == chainConfig.RelayCacheTtls.String {
+		return cached.(*chainRelayCacheTTLs).cacheTTLs
+	}
+
+	var rawCacheTTLs map[string]string
+	if err := json.Unmarshal([]byte(chainConfig.RelayCacheTtls.String), &rawCacheTTLs); err != nil {
+		r.logger.Sugar().Warnw("invalid relay cache ttls", "chain", chainId, "err", err)
+	}
+	cacheTTLs := map[string]time.Duration{}
+	for method, rawTTL := range rawCacheTTLs {
+		ttl, err := time.ParseDuration(rawTTL)
+		if err != nil || ttl <= 0 {
+			r.logger.Sugar().Warnw("invalid relay cache ttl", "chain", chainId, "method", method, "ttl", rawTTL)
+			continue
+		}
+		cacheTTLs[method] = ttl
+	}
+	r.relayCacheTTLs.Store(chainId, &chainRelayCacheTTLs{rawCacheTTLs: chainConfig.RelayCacheTtls.String, cacheTTLs: cacheTTLs})
+	return cacheTTLs
+}
+
+func compactJson(data json.RawMessage) []byte {
+	if len(data) == 0 {
+		return data
+	}
+	var buffer bytes.Buffer
+	if err := json.Compact(&buffer, data); err != nil {
+		return data
+	}
+	return buffer.Bytes()
+}
@@ -0,0 +1,210 @@
+package relayer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"github.com/valyala/fasthttp"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+const (
+	relayValidationMatch        = "match"
+	relayValidationPunished     = "punished"
+	relayValidationInconclusive = "inconclusive"
+)
+
+// validatedEvmMethods are the read-only EVM methods whose results can be compared across nodes, mapped to the position
+// of their block parameter. Methods that look up data by hash do not have a block parameter and map to -1.
+var validatedEvmMethods = map[string]int{
+	"eth_getBlockByHash":        -1,
+	"eth_getTransactionByHash":  -1,
+	"eth_getTransactionReceipt": -1,
+	"eth_getBlockByNumber":      0,
+	"eth_getBalance":            1,
+	"eth_getCode":               1,
+	"eth_getTransactionCount":   1,
+	"eth_call":                  1,
+	"eth_getStorageAt":          2,
+}
+
+// sampleRelayValidation - sends a sample of the chain's read-only EVM relays to a second node in the background, to catch
+// nodes that pass the QoS checks but answer user relays with invalid data.
+func (r *Relayer) sampleRelayValidation(req *models.SendRelayRequest, node *qos_models.QosNode, rsp *models.SendRelayResponse) {
+	sampleRate := r.getRelayValidationSampleRate(req.Chain)
+	if sampleRate <= 0 || node == nil || rand.Float64() >= sampleRate {
+		return
+	}
+	if !checks.IsEvmChainId(r.chainConfigurationRegistry, req.Chain) || !isValidatableEvmRelay(req.Payload) {
+		return
+	}
+	result, ok := parseJsonRpcResult(rsp.Response)
+	if !ok {
+		return
+	}
+	// The request is populated with the session metadata of the second node, so it cannot be shared with the caller
+	validationReq := *req
+	go r.validateRelay(&validationReq, node, result)
+}
+
+// validateRelay - sends the relay to a second node and compares its result with the result of the node that served the relay.
+// If the results disagree, the altruist breaks the tie and the node that disagrees with the altruist is punished.
+func (r *Relayer) validateRelay(req *models.SendRelayRequest, node *qos_models.QosNode, result json.RawMessage) {
+	secondNode, err := r.selectNode(req, map[string]bool{node.GetPublicKey(): true})
+	if err != nil {
+		return
+	}
+	// Node errors are already punished by sendRelayToNode
+	secondRsp, err := r.sendRelayToNode(req, secondNode)
+	if err != nil {
+		return
+	}
+	secondResult, ok := parseJsonRpcResult(secondRsp.Response)
+	if !ok {
+		counterRelayValidation.WithLabelValues(req.Chain, relayValidationInconclusive).Inc()
+		return
+	}
+	if jsonRpcResultsMatch(result, secondResult) {
+		counterRelayValidation.WithLabelValues(req.Chain, relayValidationMatch).Inc()
+		return
+	}
+
+	altruistResult, ok := r.getAltruistTiebreak(req)
+	if !ok {
+		r.logger.Sugar().Warnw("relay validation results do not match, but the altruist could not break the tie", "chain", req.Chain, "node", node.MorseNode.ServiceUrl, "secondNode", secondNode.MorseNode.ServiceUrl)
+		counterRelayValidation.WithLabelValues(req.Chain, relayValidationInconclusive).Inc()
+		return
+	}
+	firstMatches := jsonRpcResultsMatch(result, altruistResult)
+	secondMatches := jsonRpcResultsMatch(secondResult, altruistResult)
+	switch {
+	case firstMatches && !secondMatches:
+		checks.PunishDataIntegrityNode(secondNode, fmt.Errorf("relay result %s, altruist result %s", secondResult, altruistResult), r.logger)
+	case secondMatches && !firstMatches:
+		checks.PunishDataIntegrityNode(node, fmt.Errorf("relay result %s, altruist result %s", result, altruistResult), r.logger)
+	default:
+		// Neither node can be trusted to be at fault, such as when the altruist disagrees with both nodes
+		counterRelayValidation.WithLabelValues(req.Chain, relayValidationInconclusive).Inc()
+		return
+	}
+	counterRelayValidation.WithLabelValues(req.Chain, relayValidationPunished).Inc()
+}
+
+// getAltruistTiebreak - returns the altruist's result of the relay, unless the chain's altruist circuit breaker is open.
+// Tiebreaks are sent by the gateway, so they are not counted as customer relays.
+func (r *Relayer) getAltruistTiebreak(req *models.SendRelayRequest) (json.RawMessage, bool) {
+	if !r.altruistCircuitBreaker.Allow(req.Chain) {
+		return nil, false
+	}
+	startTime := time.Now()
+	rsp, err := r.sendToAltruists(req, nil)
+	if errors.Is(err, errAltruistNotFound) {
+		r.altruistCircuitBreaker.Release(req.Chain)
+	} else {
+		r.altruistCircuitBreaker.RecordResult(req.Chain, err == nil && rsp.StatusCode < fasthttp.StatusInternalServerError, time.Since(startTime))
+	}
+	if err != nil || rsp.StatusCode >= fasthttp.StatusBadRequest {
+		return nil, false
+	}
+	return parseJsonRpcResult(rsp.Response)
+}
+
+// isValidatableEvmRelay - returns whether the relay is a read-only JSON-RPC call whose result is the same on every node.
+// Calls on a block tag such as latest are skipped since nodes that are a block apart legitimately disagree.
+func isValidatableEvmRelay(payload *models.Payload) bool {
+	if payload == nil || (payload.Method != "" && payload.Method != fasthttp.MethodPost) {
+		return false
+	}
+	var call jsonRpcCall
+	if err := json.Unmarshal([]byte(payload.Data), &call); err != nil {
+		return false
+	}
+	blockParam, ok := validatedEvmMethods[call.Method]
+	if !ok {
+		return false
+	}
+	if blockParam < 0 {
+		return true
+	}
+	var params []json.RawMessage
+	if err := json.Unmarshal(call.Params, &params); err != nil || len(params) <= blockParam {
+		// An omitted block parameter defaults to latest
+		return false
+	}
+	var block string
+	if err := json.Unmarshal(params[blockParam], &block); err != nil {
+		// Blocks passed as an object reference a block by its number or hash (EIP-1898)
+		return true
+	}
+	return strings.HasPrefix(block, "0x")
+}
+
+// parseJsonRpcResult - returns the result of a JSON-RPC response. Errors and null results, such as a block a node has
+// not synced yet, cannot be compared and are not returned.
+func parseJsonRpcResult(response string) (json.RawMessage, bool) {
+	var result jsonRpcResult
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return nil, false
+	}
+	if result.Error != nil || result.Result == nil || string(result.Result) == "null" {
+		return nil, false
+	}
+	return result.Result, true
+}
+
+// jsonRpcResultsMatch - compares two JSON-RPC results. Objects are only compared on the fields both results have,
+// since clients add their own fields to blocks and receipts.
+func jsonRpcResultsMatch(first json.RawMessage, second json.RawMessage) bool {
+	var firstValue, secondValue any
+	if json.Unmarshal(first, &firstValue) != nil || json.Unmarshal(second, &secondValue) != nil {
+		return false
+	}
+	return jsonValuesMatch(firstValue, secondValue)
+}
+
+func jsonValuesMatch(first any, second any) bool {
+	switch firstValue := first.(type) {
+	case map[string]any:
+		secondValue, ok := second.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, value := range firstValue {
+			if otherValue, ok := secondValue[key]; ok && !jsonValuesMatch(value, otherValue) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		secondValue, ok := second.([]any)
+		if !ok || len(firstValue) != len(secondValue) {
+			return false
+		}
+		for i := range firstValue {
+			if !jsonValuesMatch(firstValue[i], secondValue[i]) {
+				return false
+			}
+		}
+		return true
+	case string:
+		// Hex values are not case sensitive
+		secondValue, ok := second.(string)
+		return ok && strings.EqualFold(firstValue, secondValue)
+	default:
+		return first == second
+	}
+}
+
+// getRelayValidationSampleRate - returns the share of the chain's relays that are validated, zero if validation is disabled.
+func (r *Relayer) getRelayValidationSampleRate(chainId string) float64 {
+	chainConfig, ok := r.chainConfigurationRegistry.GetChainConfiguration(chainId)
+	if !ok || chainConfig.RelayValidationSampleRate == nil {
+		return 0
+	}
+	return float64(*chainConfig.RelayValidationSampleRate)
+}
@@ -1,40 +1,56 @@
 package relayer
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/pokt-network/gateway-server/internal/altruist_circuit_breaker"
+	"github.com/pokt-network/gateway-server/internal/altruist_registry"
 	"github.com/pokt-network/gateway-server/internal/apps_registry"
 	"github.com/pokt-network/gateway-server/internal/chain_configurations_registry"
 	"github.com/pokt-network/gateway-server/internal/global_config"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service"
 	"github.com/pokt-network/gateway-server/internal/node_selector_service/checks"
+	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
 	"github.com/pokt-network/gateway-server/internal/session_registry"
 	"github.com/pokt-network/gateway-server/pkg/common"
 	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0"
 	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
+	"github.com/pokt-network/gateway-server/pkg/ttl_cache"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/valyala/fasthttp"
 	"go.uber.org/zap"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 var (
 	counterRelayRequest                      *prometheus.CounterVec
+	counterRelayRetry                        *prometheus.CounterVec
+	counterRelayHedge                        *prometheus.CounterVec
+	counterRelayHedgeWinner                  *prometheus.CounterVec
+	counterRelayValidation                   *prometheus.CounterVec
 	histogramRelayRequestLatency             *prometheus.HistogramVec
 	pocketClientHistogramRelayRequestLatency *prometheus.HistogramVec
 )
 
+const (
+	// by default, a relay is only attempted on a single node before falling back to the altruist
+	defaultRelayMaxAttempts = 1
+	// default total amount of time a relay can be retried for across nodes
+	defaultRelayRetryDeadline = time.Second * 10
+)
+
 const (
 	reasonRelayFailedSessionErr = "relay_session_failure"
 	reasonRelayFailedPocketErr  = "relay_pocket_error"
 )
 
 var (
-	errAltruistNotFound = errors.New("altruist url not found")
-	errSelectNodeFail   = errors.New("node selector can't find node")
+	errAltruistNotFound = errors.New("altruist not found")
 )
 
 func init() {
@@ -43,7 +59,35 @@ func init() {
 			Name: "relay_counter",
 			Help: "Request to send an actual relay and if it succeeded",
 		},
-		[]string{"success", "altruist", "reason", "chain_id", "service_host"},
+		[]string{"success", "altruist", "reason", "chain_id", "service_host", "cached"},
+	)
+	counterRelayRetry = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_retry_counter",
+			Help: "Relays that were retried on a different node after a node error",
+		},
+		[]string{"chain_id"},
+	)
+	counterRelayHedge = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_hedge_counter",
+			Help: "Relays that were sent to a second node because the first node did not answer within the hedge delay",
+		},
+		[]string{"chain_id"},
+	)
+	counterRelayHedgeWinner = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_hedge_winner_counter",
+			Help: "Successful hedged relays and whether the hedged relay won",
+		},
+		[]string{"chain_id", "hedged"},
+	)
+	counterRelayValidation = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_validation_counter",
+			Help: "Sampled relays that were validated against a second node, by whether the results matched or a node was punished",
+		},
+		[]string{"chain_id", "result"},
 	)
 	histogramRelayRequestLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -61,36 +105,61 @@ func init() {
 		},
 		[]string{"success", "chain_id", "service_host"},
 	)
-	prometheus.MustRegister(counterRelayRequest, histogramRelayRequestLatency, pocketClientHistogramRelayRequestLatency)
+	prometheus.MustRegister(counterRelayRequest, counterRelayRetry, counterRelayHedge, counterRelayHedgeWinner, counterRelayValidation, histogramRelayRequestLatency, pocketClientHistogramRelayRequestLatency)
 }
 
 type Relayer struct {
 	globalConfigProvider       global_config.GlobalConfigProvider
 	pocketClient               pokt_v0.PocketService
 	chainConfigurationRegistry chain_configurations_registry.ChainConfigurationsService
+	altruistRegistry           altruist_registry.AltruistRegistryService
+	altruistCircuitBreaker     altruist_circuit_breaker.CircuitBreakerService
 	sessionRegistry            session_registry.SessionRegistryService
 	nodeSelector               node_selector_service.NodeSelectorService
 	applicationRegistry        apps_registry.AppsRegistryService
 	httpRequester              httpRequester
+	relayCache                 ttl_cache.TTLCacheService[string, json.RawMessage]
+	relayCacheTTLs             sync.Map // chain id > parsed relay_cache_ttls
 	userAgent                  string
 	logger                     *zap.Logger
 }
 
-func NewRelayer(pocketService pokt_v0.PocketService, sessionRegistry session_registry.SessionRegistryService, applicationRegistry apps_registry.AppsRegistryService, nodeSelector node_selector_service.NodeSelectorService, altruistRegistry chain_configurations_registry.ChainConfigurationsService, userAgent string, globalConfigProvider global_config.GlobalConfigProvider, logger *zap.Logger) *Relayer {
+func NewRelayer(pocketService pokt_v0.PocketService, sessionRegistry session_registry.SessionRegistryService, applicationRegistry apps_registry.AppsRegistryService, nodeSelector node_selector_service.NodeSelectorService, chainConfigurationRegistry chain_configurations_registry.ChainConfigurationsService, altruistRegistry altruist_registry.AltruistRegistryService, altruistCircuitBreaker altruist_circuit_breaker.CircuitBreakerService, userAgent string, globalConfigProvider global_config.GlobalConfigProvider, logger *zap.Logger) *Relayer {
 	return &Relayer{
 		pocketClient:               pocketService,
 		sessionRegistry:            sessionRegistry,
 		logger:                     logger,
-		chainConfigurationRegistry: altruistRegistry,
+		chainConfigurationRegistry: chainConfigurationRegistry,
+		altruistRegistry:           altruistRegistry,
+		altruistCircuitBreaker:     altruistCircuitBreaker,
 		applicationRegistry:        applicationRegistry,
 		nodeSelector:               nodeSelector,
 		httpRequester:              fastHttpRequester{},
+		relayCache:                 newRelayCache(),
 		globalConfigProvider:       globalConfigProvider,
 		userAgent:                  userAgent,
 	}
 }
 
 func (r *Relayer) SendRelay(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
+	if r.getBatchRelayMode(req.Chain) == BatchRelayModeSplit {
+		if batch, ok := parseJsonRpcBatch(req.Payload); ok {
+			return r.sendSplitBatchRelay(req, batch)
+		}
+	}
+	return r.sendRelay(req)
+}
+
+// sendRelay - sends a single relay through the node selector, falling back to the altruist if the network fails.
+func (r *Relayer) sendRelay(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
+
+	cacheableRelay, cacheable := r.getCacheableRelay(req)
+	if cacheable {
+		if rsp, ok := r.getCachedRelay(cacheableRelay); ok {
+			counterRelayRequest.WithLabelValues("true", "false", "", req.Chain, "", "true").Inc()
+			return rsp, nil
+		}
+	}
 
 	success := false
 	altruist := false
@@ -102,59 +171,139 @@ func (r *Relayer) SendRelay(req *models.SendRelayRequest) (*models.SendRelayResp
 		histogramRelayRequestLatency.WithLabelValues(strconv.FormatBool(success), strconv.FormatBool(altruist), req.Chain, nodeHost).Observe(time.Since(startTime).Seconds())
 	}()
 
-	rsp, host, err := r.sendNodeSelectorRelay(req)
+	rsp, node, err := r.sendNodeSelectorRelayWithRetries(req)
 	// Set the host to record service domain
-	nodeHost = host
+	nodeHost = r.getNodeHost(node)
 
 	// Node selector relay was successful
 	if err == nil {
 		success = true
-		counterRelayRequest.WithLabelValues("true", "false", "", req.Chain, nodeHost).Inc()
+		counterRelayRequest.WithLabelValues("true", "false", "", req.Chain, nodeHost, "false").Inc()
+		if cacheable {
+			r.cacheRelay(cacheableRelay, rsp)
+		}
+		r.sampleRelayValidation(req, node, rsp)
 		return rsp, nil
 	}
 
 	altruist = true
-	counterRelayRequest.WithLabelValues("false", "true", reasonRelayFailedPocketErr, req.Chain, "").Inc()
+	counterRelayRequest.WithLabelValues("false", "true", reasonRelayFailedPocketErr, req.Chain, "", "false").Inc()
 
 	r.logger.Sugar().Errorw("failed to send to pokt", "poktErr", err)
+	// Fail fast instead of overwhelming the altruists while they are failing as well
+	if !r.altruistCircuitBreaker.Allow(req.Chain) {
+		return nil, fmt.Errorf("%w: %w", altruist_circuit_breaker.ErrCircuitOpen, err)
+	}
+	altruistStartTime := time.Now()
 	altruistRsp, altruistErr := r.altruistRelay(req)
+	if errors.Is(altruistErr, errAltruistNotFound) {
+		r.altruistCircuitBreaker.Release(req.Chain)
+	} else {
+		r.altruistCircuitBreaker.RecordResult(req.Chain, altruistErr == nil && altruistRsp.StatusCode < fasthttp.StatusInternalServerError, time.Since(altruistStartTime))
+	}
 	if altruistErr != nil {
 		r.logger.Sugar().Errorw("failed to send to altruist", "altruistError", altruistErr)
 		// Prefer to return the network error vs altruist error if both fails.
 		return nil, err
 	}
+	if cacheable {
+		r.cacheRelay(cacheableRelay, altruistRsp)
+	}
 	return altruistRsp, nil
 }
 
-func (r *Relayer) sendNodeSelectorRelay(req *models.SendRelayRequest) (*models.SendRelayResponse, string, error) {
+// sendNodeSelectorRelayWithRetries - sends a relay to a node from the node selector and retries on a node that has not been attempted yet
+// whenever the node is at fault, until the chain's max attempts are used or the retry deadline has passed.
+func (r *Relayer) sendNodeSelectorRelayWithRetries(req *models.SendRelayRequest) (*models.SendRelayResponse, *qos_models.QosNode, error) {
+	maxAttempts := r.getRelayMaxAttempts(req.Chain)
+	if maxAttempts <= 1 {
+		return r.sendNodeSelectorAttempt(req, map[string]bool{})
+	}
+
+	deadline := time.Now().Add(r.getRelayRetryDeadline(req.Chain))
+	pocketRequestTimeout := r.getPocketRequestTimeout(req.Chain)
+	attemptedNodes := map[string]bool{}
+	for attempt := 1; ; attempt++ {
+		// Each attempt is bound by the remaining time until the deadline
+		requestTimeout := min(pocketRequestTimeout, time.Until(deadline))
+		req.Timeout = &requestTimeout
+
+		rsp, node, err := r.sendNodeSelectorAttempt(req, attemptedNodes)
+
+		// Only retry if the node was at fault, other errors would fail on any node
+		if err == nil || attempt >= maxAttempts || !checks.IsNodeError(err) || time.Until(deadline) <= 0 {
+			return rsp, node, err
+		}
+		counterRelayRetry.WithLabelValues(req.Chain).Inc()
+		r.logger.Sugar().Debugw("retrying relay on a different node", "chain", req.Chain, "attempt", attempt, "err", err)
+	}
+}
+
+// sendNodeSelectorAttempt - sends a single relay attempt, which is hedged on a second node if the chain has hedging enabled.
+func (r *Relayer) sendNodeSelectorAttempt(req *models.SendRelayRequest, attemptedNodes map[string]bool) (*models.SendRelayResponse, *qos_models.QosNode, error) {
+	hedgeDelay, ok := r.getRelayHedgeDelay(req.Chain)
+	if !ok {
+		return r.sendNodeSelectorRelay(req, attemptedNodes)
+	}
+	return r.sendHedgedNodeSelectorRelay(req, attemptedNodes, hedgeDelay)
+}
+
+// sendNodeSelectorRelay - sends a relay to a node from the node selector that is not part of attemptedNodes. The selected node is added to attemptedNodes
+// and returned along with the response.
+func (r *Relayer) sendNodeSelectorRelay(req *models.SendRelayRequest, attemptedNodes map[string]bool) (*models.SendRelayResponse, *qos_models.QosNode, error) {
 	// find a node to send too first.
-	node, ok := r.nodeSelector.FindNode(req.Chain)
+	node, err := r.selectNode(req, attemptedNodes)
+	if err != nil {
+		return nil, nil, err
+	}
+	rsp, err := r.sendRelayToNode(req, node)
+	return rsp, node, err
+}
+
+// selectNode - finds a node from the node selector that is not part of attemptedNodes and adds it to attemptedNodes.
+// Archival relays are only sent to archival nodes, which are only detected on EVM chains.
+func (r *Relayer) selectNode(req *models.SendRelayRequest, attemptedNodes map[string]bool) (*qos_models.QosNode, error) {
+	var filters []node_selector_service.NodeFilter
+	if len(attemptedNodes) > 0 {
+		filters = append(filters, node_selector_service.ExcludeNodes(attemptedNodes))
+	}
+	if req.Archival && checks.IsEvmChainId(r.chainConfigurationRegistry, req.Chain) {
+		filters = append(filters, node_selector_service.ArchivalNodes())
+	}
+	node, ok := r.nodeSelector.FindNode(req.Chain, filters...)
 	if !ok {
-		return nil, "", errSelectNodeFail
+		return nil, models.ErrNoNodeAvailable
 	}
+	attemptedNodes[node.GetPublicKey()] = true
+	return node, nil
+}
+
+// sendRelayToNode - populates the request with the node's session metadata, sends the relay and records the node's latency.
+func (r *Relayer) sendRelayToNode(req *models.SendRelayRequest, node *qos_models.QosNode) (*models.SendRelayResponse, error) {
 	req.Signer = node.MorseSigner
 	req.Session = node.MorseSession
 	req.SelectedNodePubKey = node.GetPublicKey()
 	if err := req.Validate(); err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	startRequestTime := time.Now()
 
+	node.IncrementOutstandingRequests()
 	rsp, err := r.pocketClient.SendRelay(req)
+	node.DecrementOutstandingRequests()
 
 	// Record latency to prom and latency tracker
 	latency := time.Now().Sub(startRequestTime)
 
-	nodeHost := r.extractHostFromServiceUrl(node.MorseNode.ServiceUrl)
-	pocketClientHistogramRelayRequestLatency.WithLabelValues(strconv.FormatBool(err == nil), req.Chain, nodeHost).Observe(latency.Seconds())
+	pocketClientHistogramRelayRequestLatency.WithLabelValues(strconv.FormatBool(err == nil), req.Chain, r.getNodeHost(node)).Observe(latency.Seconds())
 	node.GetLatencyTracker().RecordMeasurement(float64(latency.Milliseconds()))
 	// Node returned an error, potentially penalize the node operator dependent on error
 	if err != nil {
 		checks.DefaultPunishNode(err, node, r.logger)
 	}
 
-	return rsp, nodeHost, err
+	return rsp, err
 }
 
 func (r *Relayer) sendRandomNodeRelay(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
@@ -176,7 +325,7 @@ func (r *Relayer) sendRandomNodeRelay(req *models.SendRelayRequest) (*models.Sen
 	})
 
 	if err != nil {
-		counterRelayRequest.WithLabelValues("false", "true", reasonRelayFailedSessionErr, req.Chain, "").Inc()
+		counterRelayRequest.WithLabelValues("false", "true", reasonRelayFailedSessionErr, req.Chain, "", "false").Inc()
 		return nil, err
 	}
 
@@ -194,19 +343,44 @@ func (r *Relayer) sendRandomNodeRelay(req *models.SendRelayRequest) (*models.Sen
 	rsp, err := r.pocketClient.SendRelay(req)
 
 	// record if relay was successful
-	counterRelayRequest.WithLabelValues(strconv.FormatBool(err == nil), "false", "", req.Chain, r.extractHostFromServiceUrl(randomNode.MorseNode.ServiceUrl)).Inc()
+	counterRelayRequest.WithLabelValues(strconv.FormatBool(err == nil), "false", "", req.Chain, r.extractHostFromServiceUrl(randomNode.MorseNode.ServiceUrl), "false").Inc()
 
 	return rsp, err
 }
 
+// altruistRelay - sends a customer relay to the chain's altruists, every attempt is counted in relay_counter.
 func (r *Relayer) altruistRelay(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
+	return r.sendToAltruists(req, func(success bool) {
+		counterRelayRequest.WithLabelValues(strconv.FormatBool(success), "true", "", req.Chain, "", "false").Inc()
+	})
+}
 
-	chainConfig, ok := r.chainConfigurationRegistry.GetChainConfiguration(req.Chain)
+// sendToAltruists - sends the relay to the chain's altruists in order until one of them answers without a server error.
+// recordAttempt is called with the outcome of every attempt, if provided.
+func (r *Relayer) sendToAltruists(req *models.SendRelayRequest, recordAttempt func(success bool)) (*models.SendRelayResponse, error) {
 
-	if !ok {
+	altruists := r.altruistRegistry.GetAltruists(req.Chain)
+
+	if len(altruists) == 0 {
 		return nil, errAltruistNotFound
 	}
 
+	var rsp *models.SendRelayResponse
+	var err error
+	for _, altruist := range altruists {
+		rsp, err = r.sendAltruistRelay(req, altruist)
+		if recordAttempt != nil {
+			recordAttempt(err == nil)
+		}
+		if err == nil && rsp.StatusCode < fasthttp.StatusInternalServerError {
+			return rsp, nil
+		}
+		r.logger.Sugar().Debugw("altruist relay failed", "chain", req.Chain, "altruist", altruist.ID, "err", err)
+	}
+	return rsp, err
+}
+
+func (r *Relayer) sendAltruistRelay(req *models.SendRelayRequest, altruist *altruist_registry.Altruist) (*models.SendRelayResponse, error) {
 	// Send to altruist
 	request := fasthttp.AcquireRequest()
 	response := fasthttp.AcquireResponse()
@@ -216,25 +390,37 @@ func (r *Relayer) altruistRelay(req *models.SendRelayRequest) (*models.SendRelay
 		fasthttp.ReleaseResponse(response)
 	}()
 
-	requestTimeout := r.getAltruistRequestTimeout(req.Chain)
+	requestTimeout := altruist.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = r.getAltruistRequestTimeout(req.Chain)
+	}
 	request.Header.SetUserAgent(r.userAgent)
-	request.SetRequestURI(chainConfig.AltruistUrl.String)
+	request.SetRequestURI(altruist.Url + req.Payload.Path)
+	for header, value := range req.Payload.Headers {
+		request.Header.Set(header, value)
+	}
+	// The altruist's headers are set last so that clients cannot override its credentials
+	for header, value := range altruist.Headers {
+		request.Header.Set(header, value)
+	}
 
-	if req.Payload.Method == "POST" {
+	// Relays without a method are JSON-RPC calls
+	method := req.Payload.Method
+	if method == "" {
+		method = fasthttp.MethodPost
+	}
+	request.Header.SetMethod(method)
+	if method != fasthttp.MethodGet {
 		request.SetBody([]byte(req.Payload.Data))
 	}
 
 	err := r.httpRequester.DoTimeout(request, response, requestTimeout)
-
-	success := err == nil
-	counterRelayRequest.WithLabelValues(strconv.FormatBool(success), "true", "", req.Chain, "").Inc()
-
-	if !success {
+	if err != nil {
 		return nil, err
 	}
 
 	str := string(response.Body())
-	return &models.SendRelayResponse{Response: str}, nil
+	return &models.SendRelayResponse{Response: str, StatusCode: response.StatusCode(), Headers: pokt_v0.GetRelayResponseHeaders(&response.Header), Altruist: true}, nil
 }
 
 func (r *Relayer) getAltruistRequestTimeout(chainId string) time.Duration {
@@ -261,6 +447,34 @@ func (r *Relayer) getPocketRequestTimeout(chainId string) time.Duration {
 	return configTime
 }
 
+func (r *Relayer) getRelayMaxAttempts(chainId string) int {
+	chainConfig, ok := r.chainConfigurationRegistry.GetChainConfiguration(chainId)
+	if !ok || chainConfig.RelayMaxAttempts == nil || *chainConfig.RelayMaxAttempts < 1 {
+		return defaultRelayMaxAttempts
+	}
+	return int(*chainConfig.RelayMaxAttempts)
+}
+
+func (r *Relayer) getRelayRetryDeadline(chainId string) time.Duration {
+	chainConfig, ok := r.chainConfigurationRegistry.GetChainConfiguration(chainId)
+	if !ok {
+		return defaultRelayRetryDeadline
+	}
+	configTime, err := time.ParseDuration(chainConfig.RelayRetryDeadlineDuration.String)
+	if err != nil {
+		return defaultRelayRetryDeadline
+	}
+	return configTime
+}
+
+// getNodeHost - returns the root domain of the node's service url, or an empty string without a node.
+func (r *Relayer) getNodeHost(node *qos_models.QosNode) string {
+	if node == nil {
+		return ""
+	}
+	return r.extractHostFromServiceUrl(node.MorseNode.ServiceUrl)
+}
+
 func (r *Relayer) extractHostFromServiceUrl(urlStr string) string {
 	if !r.globalConfigProvider.ShouldEmitServiceUrlPromMetrics() {
 		return ""
@@ -2,17 +2,28 @@ package relayer
 
 // Basic imports
 import (
+	"encoding/json"
+	"errors"
 	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/altruist_circuit_breaker"
+	"github.com/pokt-network/gateway-server/internal/altruist_registry"
 	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/node_selector_service"
 	qos_models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
+	altruist_circuit_breaker_mock "github.com/pokt-network/gateway-server/mocks/altruist_circuit_breaker"
+	altruist_registry_mock "github.com/pokt-network/gateway-server/mocks/altruist_registry"
 	apps_registry_mock "github.com/pokt-network/gateway-server/mocks/apps_registry"
 	chain_configurations_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_configurations_registry"
 	global_config_mock "github.com/pokt-network/gateway-server/mocks/global_config"
 	node_selector_mock "github.com/pokt-network/gateway-server/mocks/node_selector"
 	pocket_service_mock "github.com/pokt-network/gateway-server/mocks/pocket_service"
 	session_registry_mock "github.com/pokt-network/gateway-server/mocks/session_registry"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"github.com/valyala/fasthttp"
 	"go.uber.org/zap"
+	"strings"
 	"time"
 
 	"github.com/pokt-network/gateway-server/pkg/pokt/pokt_v0/models"
@@ -23,6 +34,8 @@ type RelayerTestSuite struct {
 	suite.Suite
 	mockNodeSelectorService        *node_selector_mock.NodeSelectorService
 	mockChainConfigurationsService *chain_configurations_registry_mock.ChainConfigurationsService
+	mockAltruistRegistry           *altruist_registry_mock.AltruistRegistryService
+	mockAltruistCircuitBreaker     *altruist_circuit_breaker_mock.CircuitBreakerService
 	mockSessionRegistryService     *session_registry_mock.SessionRegistryService
 	mockPocketService              *pocket_service_mock.PocketService
 	mockAppRegistry                *apps_registry_mock.AppsRegistryService
@@ -35,9 +48,14 @@ func (suite *RelayerTestSuite) SetupTest() {
 	suite.mockNodeSelectorService = new(node_selector_mock.NodeSelectorService)
 	suite.mockSessionRegistryService = new(session_registry_mock.SessionRegistryService)
 	suite.mockChainConfigurationsService = new(chain_configurations_registry_mock.ChainConfigurationsService)
+	suite.mockAltruistRegistry = new(altruist_registry_mock.AltruistRegistryService)
+	suite.mockAltruistCircuitBreaker = new(altruist_circuit_breaker_mock.CircuitBreakerService)
+	suite.mockAltruistCircuitBreaker.EXPECT().Allow(mock.Anything).Return(true).Maybe()
+	suite.mockAltruistCircuitBreaker.EXPECT().RecordResult(mock.Anything, mock.Anything, mock.Anything).Maybe()
+	suite.mockAltruistCircuitBreaker.EXPECT().Release(mock.Anything).Maybe()
 	suite.mockAppRegistry = new(apps_registry_mock.AppsRegistryService)
 	suite.mockConfigProvider = new(global_config_mock.GlobalConfigProvider)
-	suite.relayer = NewRelayer(suite.mockPocketService, suite.mockSessionRegistryService, suite.mockAppRegistry, suite.mockNodeSelectorService, suite.mockChainConfigurationsService, "", suite.mockConfigProvider, zap.NewNop())
+	suite.relayer = NewRelayer(suite.mockPocketService, suite.mockSessionRegistryService, suite.mockAppRegistry, suite.mockNodeSelectorService, suite.mockChainConfigurationsService, suite.mockAltruistRegistry, suite.mockAltruistCircuitBreaker, "", suite.mockConfigProvider, zap.NewNop())
 }
 
 func (suite *RelayerTestSuite) TestNodeSelectorRelay() {
@@ -63,7 +81,7 @@ func (suite *RelayerTestSuite) TestNodeSelectorRelay() {
 			},
 			expectedResponse: nil,
 			expectedNodeHost: "",
-			expectedError:    errSelectNodeFail,
+			expectedError:    models.ErrNoNodeAvailable,
 		},
 		{
 			name: "Success",
@@ -101,18 +119,258 @@ func (suite *RelayerTestSuite) TestNodeSelectorRelay() {
 
 			tc.setupMocks(tc.request) // setup mocks
 
-			rsp, host, err := suite.relayer.sendNodeSelectorRelay(tc.request)
+			rsp, node, err := suite.relayer.sendNodeSelectorRelay(tc.request, map[string]bool{})
 
 			// assert results
 			suite.Equal(tc.expectedResponse, rsp)
-			suite.Equal(tc.expectedNodeHost, host)
+			suite.Equal(tc.expectedNodeHost, suite.relayer.getNodeHost(node))
 			suite.Equal(tc.expectedError, err)
 		})
 	}
 
 }
 
+func (suite *RelayerTestSuite) TestNodeSelectorRelayWithRetries() {
+
+	expectedResponse := &models.SendRelayResponse{Response: "response"}
+	nodeErr := fasthttp.ErrTimeout
+	nonNodeErr := errors.New("uncategorized error")
+	maxAttempts := int32(2)
+	retryDeadline := pgtype.Varchar{}
+	retryDeadline.Set("10s")
+
+	newNode := func(publicKey string) *qos_models.QosNode {
+		return qos_models.NewQosNode(&models.Node{PublicKey: publicKey, ServiceUrl: "http://" + publicKey + ".com"}, &models.Session{}, &models.Ed25519Account{})
+	}
+
+	testCases := []struct {
+		name             string
+		maxAttempts      *int32
+		setupMocks       func()
+		expectedResponse *models.SendRelayResponse
+		expectedError    error
+	}{
+		{
+			name:        "RetriedOnDifferentNode",
+			maxAttempts: &maxAttempts,
+			setupMocks: func() {
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(newNode("first"), true).Once()
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234", mock.Anything).Return(newNode("second"), true).Once()
+				suite.mockPocketService.EXPECT().SendRelay(mock.Anything).Return(nil, nodeErr).Once()
+				suite.mockPocketService.EXPECT().SendRelay(mock.Anything).Return(expectedResponse, nil).Once()
+			},
+			expectedResponse: expectedResponse,
+			expectedError:    nil,
+		},
+		{
+			name:        "NotRetriedOnNonNodeError",
+			maxAttempts: &maxAttempts,
+			setupMocks: func() {
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(newNode("first"), true).Once()
+				suite.mockPocketService.EXPECT().SendRelay(mock.Anything).Return(nil, nonNodeErr).Once()
+			},
+			expectedResponse: nil,
+			expectedError:    nonNodeErr,
+		},
+		{
+			name:        "MaxAttemptsReached",
+			maxAttempts: &maxAttempts,
+			setupMocks: func() {
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(newNode("first"), true).Once()
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234", mock.Anything).Return(newNode("second"), true).Once()
+				suite.mockPocketService.EXPECT().SendRelay(mock.Anything).Return(nil, nodeErr).Twice()
+			},
+			expectedResponse: nil,
+			expectedError:    nodeErr,
+		},
+		{
+			name:        "RetriesDisabledByDefault",
+			maxAttempts: nil,
+			setupMocks: func() {
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(newNode("first"), true).Once()
+				suite.mockPocketService.EXPECT().SendRelay(mock.Anything).Return(nil, nodeErr).Once()
+			},
+			expectedResponse: nil,
+			expectedError:    nodeErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+
+			suite.SetupTest() // reset mocks
+
+			suite.mockConfigProvider.EXPECT().ShouldEmitServiceUrlPromMetrics().Return(false)
+			suite.mockConfigProvider.EXPECT().GetPoktRPCRequestTimeout().Return(time.Second * 5).Maybe()
+			suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{RelayMaxAttempts: tc.maxAttempts, RelayRetryDeadlineDuration: retryDeadline}, true)
+			tc.setupMocks()
+
+			rsp, _, err := suite.relayer.sendNodeSelectorRelayWithRetries(&models.SendRelayRequest{Payload: &models.Payload{}, Chain: "1234"})
+
+			suite.Equal(tc.expectedResponse, rsp)
+			suite.Equal(tc.expectedError, err)
+			suite.mockNodeSelectorService.AssertExpectations(suite.T())
+			suite.mockPocketService.AssertExpectations(suite.T())
+		})
+	}
+}
+
+func (suite *RelayerTestSuite) TestHedgedNodeSelectorRelay() {
+
+	hedgeDelay := pgtype.Varchar{}
+	hedgeDelay.Set("10ms")
+
+	newNode := func(publicKey string) *qos_models.QosNode {
+		return qos_models.NewQosNode(&models.Node{PublicKey: publicKey, ServiceUrl: "http://" + publicKey + ".com"}, &models.Session{}, &models.Ed25519Account{})
+	}
+	// slow node answers after the hedge delay, so the relay is hedged to the fast node
+	sendRelay := func(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
+		if req.SelectedNodePubKey == "slow" {
+			time.Sleep(time.Millisecond * 200)
+		}
+		return &models.SendRelayResponse{Response: req.SelectedNodePubKey}, nil
+	}
+
+	testCases := []struct {
+		name             string
+		setupMocks       func()
+		expectedResponse string
+	}{
+		{
+			name: "HedgedRelayWins",
+			setupMocks: func() {
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(newNode("slow"), true).Once()
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234", mock.Anything).Return(newNode("fast"), true).Once()
+			},
+			expectedResponse: "fast",
+		},
+		{
+			name: "PrimaryRelayWins",
+			setupMocks: func() {
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(newNode("fast"), true).Once()
+			},
+			expectedResponse: "fast",
+		},
+		{
+			name: "NoNodeToHedgeWith",
+			setupMocks: func() {
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(newNode("slow"), true).Once()
+				suite.mockNodeSelectorService.EXPECT().FindNode("1234", mock.Anything).Return(nil, false).Once()
+			},
+			expectedResponse: "slow",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+
+			suite.SetupTest() // reset mocks
+
+			suite.mockConfigProvider.EXPECT().ShouldEmitServiceUrlPromMetrics().Return(false)
+			suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{RelayHedgeDelayDuration: hedgeDelay}, true)
+			suite.mockPocketService.EXPECT().SendRelay(mock.Anything).RunAndReturn(sendRelay)
+			tc.setupMocks()
+
+			rsp, _, err := suite.relayer.sendNodeSelectorAttempt(&models.SendRelayRequest{Payload: &models.Payload{}, Chain: "1234"}, map[string]bool{})
+
+			suite.Nil(err)
+			suite.Equal(tc.expectedResponse, rsp.Response)
+		})
+	}
+}
+
 // test TestNodeSelectorRelay using table driven tests
+func (suite *RelayerTestSuite) TestSplitBatchRelay() {
+
+	batchRelayMode := pgtype.Varchar{}
+	batchRelayMode.Set(BatchRelayModeSplit)
+
+	// nodes echo the request back, except for calls to the failing method
+	sendRelay := func(req *models.SendRelayRequest) (*models.SendRelayResponse, error) {
+		if strings.Contains(req.Payload.Data, "eth_fail") {
+			return nil, errors.New("relay failed")
+		}
+		return &models.SendRelayResponse{Response: req.Payload.Data}, nil
+	}
+
+	testCases := []struct {
+		name             string
+		payload          string
+		expectedResponse string
+	}{
+		{
+			name:             "ResponsesInOriginalOrder",
+			payload:          `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":"two","method":"eth_chainId"}]`,
+			expectedResponse: `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":"two","method":"eth_chainId"}]`,
+		},
+		{
+			name:             "PartialFailure",
+			payload:          `[{"jsonrpc":"2.0","id":1,"method":"eth_fail"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`,
+			expectedResponse: `[{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":"upstream error"}},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`,
+		},
+		{
+			name:             "NotificationsAreNotAnswered",
+			payload:          `[{"jsonrpc":"2.0","method":"eth_subscribe"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`,
+			expectedResponse: `[{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`,
+		},
+		{
+			name:             "OnlyNotifications",
+			payload:          `[{"jsonrpc":"2.0","method":"eth_subscribe"},{"jsonrpc":"2.0","method":"eth_fail"}]`,
+			expectedResponse: "",
+		},
+		{
+			name:             "InvalidCall",
+			payload:          `[1,{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`,
+			expectedResponse: `[{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"invalid request"}},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`,
+		},
+		{
+			name:             "EmptyBatch",
+			payload:          `[]`,
+			expectedResponse: `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"empty batch"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+
+			suite.SetupTest() // reset mocks
+
+			node := qos_models.NewQosNode(&models.Node{PublicKey: "123", ServiceUrl: "http://node.com"}, &models.Session{}, &models.Ed25519Account{})
+			suite.mockConfigProvider.EXPECT().ShouldEmitServiceUrlPromMetrics().Return(false).Maybe()
+			suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{BatchRelayMode: batchRelayMode}, true)
+			suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(node, true).Maybe()
+			suite.mockPocketService.EXPECT().SendRelay(mock.Anything).RunAndReturn(sendRelay).Maybe()
+			suite.mockAltruistRegistry.EXPECT().GetAltruists("1234").Return(nil).Maybe()
+
+			rsp, err := suite.relayer.SendRelay(&models.SendRelayRequest{Payload: &models.Payload{Data: tc.payload}, Chain: "1234"})
+
+			suite.Nil(err)
+			if tc.expectedResponse == "" {
+				suite.Empty(rsp.Response)
+				return
+			}
+			suite.JSONEq(tc.expectedResponse, rsp.Response)
+		})
+	}
+}
+
+func (suite *RelayerTestSuite) TestPassthroughBatchRelay() {
+	node := qos_models.NewQosNode(&models.Node{PublicKey: "123", ServiceUrl: "http://node.com"}, &models.Session{}, &models.Ed25519Account{})
+	payload := `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`
+
+	suite.mockConfigProvider.EXPECT().ShouldEmitServiceUrlPromMetrics().Return(false)
+	suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{}, true)
+	suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(node, true).Once()
+	suite.mockPocketService.EXPECT().SendRelay(mock.MatchedBy(func(req *models.SendRelayRequest) bool {
+		return req.Payload.Data == payload
+	})).Return(&models.SendRelayResponse{Response: "batch"}, nil).Once()
+
+	rsp, err := suite.relayer.SendRelay(&models.SendRelayRequest{Payload: &models.Payload{Data: payload}, Chain: "1234"})
+
+	suite.Nil(err)
+	suite.Equal("batch", rsp.Response)
+}
+
 func (suite *RelayerTestSuite) TestAltruistRelay() {
 
 	// create test cases
@@ -130,7 +388,7 @@ func (suite *RelayerTestSuite) TestAltruistRelay() {
 				Chain:   "1234",
 			},
 			setupMocks: func(request *models.SendRelayRequest) {
-				suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration(request.Chain).Return(db_query.GetChainConfigurationsRow{}, false)
+				suite.mockAltruistRegistry.EXPECT().GetAltruists(request.Chain).Return(nil)
 			},
 			expectedResponse: nil,
 			expectedError:    errAltruistNotFound,
@@ -142,11 +400,10 @@ func (suite *RelayerTestSuite) TestAltruistRelay() {
 				Chain:   "1234",
 			},
 			setupMocks: func(request *models.SendRelayRequest) {
-				pgTypeStr := pgtype.Varchar{}
-				pgTypeStr.Set("https://example.com")
 				// We can only check if altruist url and if proper config is called
 				suite.mockConfigProvider.EXPECT().GetAltruistRequestTimeout().Return(time.Second * 15)
-				suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration(request.Chain).Return(db_query.GetChainConfigurationsRow{AltruistUrl: pgTypeStr}, true)
+				suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration(request.Chain).Return(db_query.GetChainConfigurationsRow{}, false)
+				suite.mockAltruistRegistry.EXPECT().GetAltruists(request.Chain).Return([]*altruist_registry.Altruist{{Url: "https://example.com"}})
 			},
 			expectedResponse: nil,
 			expectedError:    nil,
@@ -171,6 +428,362 @@ func (suite *RelayerTestSuite) TestAltruistRelay() {
 
 }
 
+func (suite *RelayerTestSuite) TestCachedRelay() {
+
+	cacheTTLs := pgtype.Varchar{}
+	cacheTTLs.Set(`{"eth_chainId": "1h", "eth_getTransactionReceipt": "1m"}`)
+
+	testCases := []struct {
+		name              string
+		payloads          []string
+		nodeResponse      string
+		chainHeight       uint64
+		expectedRelays    int
+		expectedResponses []string
+	}{
+		{
+			name:              "CachedMethod",
+			payloads:          []string{`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`, `{"jsonrpc":"2.0","id":"two","method":"eth_chainId","params":[]}`},
+			nodeResponse:      `{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+			expectedRelays:    1,
+			expectedResponses: []string{`{"jsonrpc":"2.0","id":1,"result":"0x1"}`, `{"jsonrpc":"2.0","id":"two","result":"0x1"}`},
+		},
+		{
+			name:              "DifferentParams",
+			payloads:          []string{`{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["0x1"]}`, `{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["0x2"]}`},
+			nodeResponse:      `{"jsonrpc":"2.0","id":1,"result":{}}`,
+			expectedRelays:    2,
+			expectedResponses: []string{`{"jsonrpc":"2.0","id":1,"result":{}}`, `{"jsonrpc":"2.0","id":1,"result":{}}`},
+		},
+		{
+			name:              "FinalReceipt",
+			payloads:          []string{`{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["0x1"]}`, `{"jsonrpc":"2.0","id":2,"method":"eth_getTransactionReceipt","params":["0x1"]}`},
+			nodeResponse:      `{"jsonrpc":"2.0","id":1,"result":{"blockNumber":"0x64"}}`,
+			chainHeight:       0x64 + 25,
+			expectedRelays:    1,
+			expectedResponses: []string{`{"jsonrpc":"2.0","id":1,"result":{"blockNumber":"0x64"}}`, `{"jsonrpc":"2.0","id":2,"result":{"blockNumber":"0x64"}}`},
+		},
+		{
+			name:              "ReceiptNotFinal",
+			payloads:          []string{`{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["0x1"]}`, `{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["0x1"]}`},
+			nodeResponse:      `{"jsonrpc":"2.0","id":1,"result":{"blockNumber":"0x64"}}`,
+			chainHeight:       0x64 + 24,
+			expectedRelays:    2,
+			expectedResponses: []string{`{"jsonrpc":"2.0","id":1,"result":{"blockNumber":"0x64"}}`, `{"jsonrpc":"2.0","id":1,"result":{"blockNumber":"0x64"}}`},
+		},
+		{
+			name:              "NullResultNotCached",
+			payloads:          []string{`{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["0x1"]}`, `{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["0x1"]}`},
+			nodeResponse:      `{"jsonrpc":"2.0","id":1,"result":null}`,
+			expectedRelays:    2,
+			expectedResponses: []string{`{"jsonrpc":"2.0","id":1,"result":null}`, `{"jsonrpc":"2.0","id":1,"result":null}`},
+		},
+		{
+			name:              "ErrorNotCached",
+			payloads:          []string{`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`},
+			nodeResponse:      `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"error"}}`,
+			expectedRelays:    2,
+			expectedResponses: []string{`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"error"}}`, `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"error"}}`},
+		},
+		{
+			name:              "UncachedMethod",
+			payloads:          []string{`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`, `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`},
+			nodeResponse:      `{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+			expectedRelays:    2,
+			expectedResponses: []string{`{"jsonrpc":"2.0","id":1,"result":"0x1"}`, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+
+			suite.SetupTest() // reset mocks
+
+			node := qos_models.NewQosNode(&models.Node{PublicKey: "123", ServiceUrl: "http://node.com"}, &models.Session{}, &models.Ed25519Account{})
+			node.SetLastKnownHeight(tc.chainHeight)
+			lookback := int32(25)
+			suite.mockConfigProvider.EXPECT().ShouldEmitServiceUrlPromMetrics().Return(false)
+			suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{RelayCacheTtls: cacheTTLs, DataIntegrityCheckLookbackHeight: &lookback}, true)
+			suite.mockSessionRegistryService.EXPECT().GetNodesByChain("1234").Return([]*qos_models.QosNode{node}).Maybe()
+			suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(node, true).Times(tc.expectedRelays)
+			suite.mockPocketService.EXPECT().SendRelay(mock.Anything).Return(&models.SendRelayResponse{Response: tc.nodeResponse}, nil).Times(tc.expectedRelays)
+
+			for i, payload := range tc.payloads {
+				rsp, err := suite.relayer.SendRelay(&models.SendRelayRequest{Payload: &models.Payload{Data: payload, Method: fasthttp.MethodPost}, Chain: "1234"})
+				suite.Nil(err)
+				suite.JSONEq(tc.expectedResponses[i], rsp.Response)
+			}
+			suite.mockPocketService.AssertExpectations(suite.T())
+		})
+	}
+}
+
+func (suite *RelayerTestSuite) TestRelayCacheTTLsReplacedOnChange() {
+	firstTTLs := pgtype.Varchar{}
+	firstTTLs.Set(`{"eth_chainId": "1h"}`)
+	secondTTLs := pgtype.Varchar{}
+	secondTTLs.Set(`{"net_version": "1m"}`)
+	suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{RelayCacheTtls: firstTTLs}, true).Once()
+	suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{RelayCacheTtls: secondTTLs}, true).Once()
+
+	suite.Equal(map[string]time.Duration{"eth_chainId": time.Hour}, suite.relayer.getRelayCacheTTLs("1234"))
+	suite.Equal(map[string]time.Duration{"net_version": time.Minute}, suite.relayer.getRelayCacheTTLs("1234"))
+
+	// A changed configuration replaces the chain's parsed ttls instead of being kept next to them
+	entries := 0
+	suite.relayer.relayCacheTTLs.Range(func(key, value any) bool {
+		entries++
+		return true
+	})
+	suite.Equal(1, entries)
+}
+
+// httpRequesterFunc allows a function to be used as the relayer's http requester
+type httpRequesterFunc func(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error
+
+func (f httpRequesterFunc) DoTimeout(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+	return f(req, resp, timeout)
+}
+
+func (suite *RelayerTestSuite) TestAltruistRelayResponse() {
+	suite.mockAltruistRegistry.EXPECT().GetAltruists("1234").Return([]*altruist_registry.Altruist{{Url: "https://altruist.com", RequestTimeout: time.Second * 15}})
+	suite.relayer.httpRequester = httpRequesterFunc(func(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+		resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+		resp.Header.Set(fasthttp.HeaderContentType, "application/json")
+		resp.Header.Set(fasthttp.HeaderRetryAfter, "10")
+		resp.Header.Set("X-Internal-Header", "secret")
+		resp.SetBodyString("rate limited")
+		return nil
+	})
+
+	rsp, err := suite.relayer.altruistRelay(&models.SendRelayRequest{Payload: &models.Payload{}, Chain: "1234"})
+
+	suite.Nil(err)
+	suite.Equal(&models.SendRelayResponse{
+		Response:   "rate limited",
+		StatusCode: fasthttp.StatusTooManyRequests,
+		Headers:    map[string]string{fasthttp.HeaderContentType: "application/json", fasthttp.HeaderRetryAfter: "10"},
+		Altruist:   true,
+	}, rsp)
+}
+
+func (suite *RelayerTestSuite) TestAltruistRelayFailover() {
+	suite.mockAltruistRegistry.EXPECT().GetAltruists("1234").Return([]*altruist_registry.Altruist{
+		{Url: "https://first.com", RequestTimeout: time.Second},
+		{Url: "https://second.com", RequestTimeout: time.Second, Headers: map[string]string{"Authorization": "Bearer altruist"}},
+	})
+	var requestedHosts []string
+	suite.relayer.httpRequester = httpRequesterFunc(func(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+		requestedHosts = append(requestedHosts, string(req.Host()))
+		if string(req.Host()) == "first.com" {
+			resp.SetStatusCode(fasthttp.StatusBadGateway)
+			return nil
+		}
+		// The altruist's credentials override the client's headers
+		suite.Equal("Bearer altruist", string(req.Header.Peek("Authorization")))
+		resp.SetBodyString("response")
+		return nil
+	})
+
+	rsp, err := suite.relayer.altruistRelay(&models.SendRelayRequest{Payload: &models.Payload{Headers: map[string]string{"Authorization": "Bearer client"}}, Chain: "1234"})
+
+	suite.Nil(err)
+	suite.Equal("response", rsp.Response)
+	suite.Equal([]string{"first.com", "second.com"}, requestedHosts)
+}
+
+func (suite *RelayerTestSuite) TestAltruistCircuitOpen() {
+	suite.mockAltruistCircuitBreaker = new(altruist_circuit_breaker_mock.CircuitBreakerService)
+	suite.mockAltruistCircuitBreaker.EXPECT().Allow("1234").Return(false)
+	suite.relayer.altruistCircuitBreaker = suite.mockAltruistCircuitBreaker
+	suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{}, true)
+	suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(nil, false)
+
+	// The altruists are not called while the circuit is open
+	_, err := suite.relayer.SendRelay(&models.SendRelayRequest{Payload: &models.Payload{Data: "{}"}, Chain: "1234"})
+
+	suite.ErrorIs(err, altruist_circuit_breaker.ErrCircuitOpen)
+	suite.ErrorIs(err, models.ErrNoNodeAvailable)
+	suite.mockAltruistRegistry.AssertNotCalled(suite.T(), "GetAltruists", "1234")
+}
+
+func (suite *RelayerTestSuite) TestAltruistNotFoundReleasesCircuit() {
+	suite.mockAltruistCircuitBreaker = new(altruist_circuit_breaker_mock.CircuitBreakerService)
+	suite.mockAltruistCircuitBreaker.EXPECT().Allow("1234").Return(true)
+	suite.mockAltruistCircuitBreaker.EXPECT().Release("1234").Once()
+	suite.relayer.altruistCircuitBreaker = suite.mockAltruistCircuitBreaker
+	suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{}, true)
+	suite.mockNodeSelectorService.EXPECT().FindNode("1234").Return(nil, false)
+	suite.mockAltruistRegistry.EXPECT().GetAltruists("1234").Return(nil)
+
+	// A relay that is not sent to any altruist frees its slot instead of being recorded
+	_, err := suite.relayer.SendRelay(&models.SendRelayRequest{Payload: &models.Payload{Data: "{}"}, Chain: "1234"})
+
+	suite.ErrorIs(err, models.ErrNoNodeAvailable)
+	suite.mockAltruistCircuitBreaker.AssertExpectations(suite.T())
+	suite.mockAltruistCircuitBreaker.AssertNotCalled(suite.T(), "RecordResult", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RelayerTestSuite) TestAltruistRelayRequest() {
+	testCases := []struct {
+		name           string
+		payload        *models.Payload
+		expectedMethod string
+		expectedUri    string
+		expectedBody   string
+	}{
+		{
+			name:           "JsonRpc",
+			payload:        &models.Payload{Data: "body"},
+			expectedMethod: fasthttp.MethodPost,
+			expectedUri:    "https://altruist.com/",
+			expectedBody:   "body",
+		},
+		{
+			name:           "Get",
+			payload:        &models.Payload{Data: "ignored", Method: fasthttp.MethodGet, Path: "/v1/status?height=1", Headers: map[string]string{"Authorization": "Bearer token"}},
+			expectedMethod: fasthttp.MethodGet,
+			expectedUri:    "https://altruist.com/v1/status?height=1",
+			expectedBody:   "",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+
+			suite.SetupTest() // reset mocks
+
+			suite.mockAltruistRegistry.EXPECT().GetAltruists("1234").Return([]*altruist_registry.Altruist{{Url: "https://altruist.com", RequestTimeout: time.Second * 15}})
+			suite.relayer.httpRequester = httpRequesterFunc(func(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+				suite.Equal(tc.expectedMethod, string(req.Header.Method()))
+				suite.Equal(tc.expectedUri, req.URI().String())
+				suite.Equal(tc.expectedBody, string(req.Body()))
+				for header, value := range tc.payload.Headers {
+					suite.Equal(value, string(req.Header.Peek(header)))
+				}
+				return nil
+			})
+
+			_, err := suite.relayer.altruistRelay(&models.SendRelayRequest{Payload: tc.payload, Chain: "1234"})
+
+			suite.Nil(err)
+		})
+	}
+}
+
+func (suite *RelayerTestSuite) TestArchivalRelay() {
+	prunedNode := qos_models.NewQosNode(&models.Node{PublicKey: "pruned"}, &models.Session{}, &models.Ed25519Account{})
+	archivalNode := qos_models.NewQosNode(&models.Node{PublicKey: "archival"}, &models.Session{}, &models.Ed25519Account{})
+	archivalNode.SetArchival(true)
+
+	suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{ChainFamily: pgtype.Varchar{String: "evm", Status: pgtype.Present}}, true)
+	suite.mockNodeSelectorService.EXPECT().FindNode("1234", mock.Anything).RunAndReturn(func(chainId string, filters ...node_selector_service.NodeFilter) (*qos_models.QosNode, bool) {
+		for _, node := range []*qos_models.QosNode{prunedNode, archivalNode} {
+			if filters[0](node) {
+				return node, true
+			}
+		}
+		return nil, false
+	})
+
+	node, err := suite.relayer.selectNode(&models.SendRelayRequest{Chain: "1234", Archival: true}, map[string]bool{})
+
+	suite.Nil(err)
+	suite.Equal(archivalNode, node)
+}
+
+func (suite *RelayerTestSuite) TestRelayValidation() {
+
+	newNode := func(publicKey string) *qos_models.QosNode {
+		return qos_models.NewQosNode(&models.Node{PublicKey: publicKey, ServiceUrl: "http://" + publicKey + ".com"}, &models.Session{}, &models.Ed25519Account{})
+	}
+	jsonRpcResponse := func(result string) string {
+		return `{"jsonrpc":"2.0","id":1,"result":` + result + `}`
+	}
+
+	testCases := []struct {
+		name                   string
+		secondNodeResult       string
+		altruistResult         string
+		expectedNodePunished   bool
+		expectedSecondPunished bool
+	}{
+		{
+			name:             "ResultsMatch",
+			secondNodeResult: `{"hash":"0xABC","number":"0x1","extra":"client field"}`,
+		},
+		{
+			name:                   "SecondNodeDisagreesWithAltruist",
+			secondNodeResult:       `{"hash":"0xdef","number":"0x1"}`,
+			altruistResult:         `{"hash":"0xabc","number":"0x1"}`,
+			expectedSecondPunished: true,
+		},
+		{
+			name:                 "NodeDisagreesWithAltruist",
+			secondNodeResult:     `{"hash":"0xdef","number":"0x1"}`,
+			altruistResult:       `{"hash":"0xdef","number":"0x1"}`,
+			expectedNodePunished: true,
+		},
+		{
+			name:             "AltruistDisagreesWithBothNodes",
+			secondNodeResult: `{"hash":"0xdef","number":"0x1"}`,
+			altruistResult:   `{"hash":"0x123","number":"0x1"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+
+			suite.SetupTest() // reset mocks
+
+			node := newNode("node")
+			secondNode := newNode("second")
+			suite.mockConfigProvider.EXPECT().ShouldEmitServiceUrlPromMetrics().Return(false)
+			suite.mockNodeSelectorService.EXPECT().FindNode("1234", mock.Anything).Return(secondNode, true)
+			suite.mockPocketService.EXPECT().SendRelay(mock.Anything).Return(&models.SendRelayResponse{Response: jsonRpcResponse(tc.secondNodeResult)}, nil)
+			suite.mockAltruistRegistry.EXPECT().GetAltruists("1234").Return([]*altruist_registry.Altruist{{Url: "https://altruist.com", RequestTimeout: time.Second}}).Maybe()
+			suite.relayer.httpRequester = httpRequesterFunc(func(req *fasthttp.Request, resp *fasthttp.Response, timeout time.Duration) error {
+				resp.SetBodyString(jsonRpcResponse(tc.altruistResult))
+				return nil
+			})
+
+			altruistRelays := testutil.ToFloat64(counterRelayRequest.WithLabelValues("true", "true", "", "1234", "", "false"))
+			suite.relayer.validateRelay(&models.SendRelayRequest{Payload: &models.Payload{Data: `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x1",false]}`}, Chain: "1234"}, node, json.RawMessage(`{"number":"0x1","hash":"0xabc"}`))
+
+			// Tiebreaks are not customer relays
+			suite.Equal(altruistRelays, testutil.ToFloat64(counterRelayRequest.WithLabelValues("true", "true", "", "1234", "", "false")))
+
+			suite.Equal(tc.expectedNodePunished, node.GetTimeoutReason() == qos_models.DataIntegrityTimeout)
+			suite.Equal(tc.expectedSecondPunished, secondNode.GetTimeoutReason() == qos_models.DataIntegrityTimeout)
+			if tc.altruistResult == "" {
+				suite.mockAltruistRegistry.AssertNotCalled(suite.T(), "GetAltruists", "1234")
+			}
+		})
+	}
+}
+
+func (suite *RelayerTestSuite) TestIsValidatableEvmRelay() {
+	testCases := []struct {
+		name     string
+		payload  *models.Payload
+		expected bool
+	}{
+		{name: "LookupByHash", payload: &models.Payload{Data: `{"method":"eth_getTransactionReceipt","params":["0xabc"]}`}, expected: true},
+		{name: "BlockNumber", payload: &models.Payload{Data: `{"method":"eth_getBalance","params":["0xabc","0x10"]}`}, expected: true},
+		{name: "BlockHashObject", payload: &models.Payload{Data: `{"method":"eth_call","params":[{},{"blockHash":"0xabc"}]}`}, expected: true},
+		{name: "LatestBlockTag", payload: &models.Payload{Data: `{"method":"eth_getBalance","params":["0xabc","latest"]}`}, expected: false},
+		{name: "OmittedBlock", payload: &models.Payload{Data: `{"method":"eth_call","params":[{}]}`}, expected: false},
+		{name: "WriteMethod", payload: &models.Payload{Data: `{"method":"eth_sendRawTransaction","params":["0xabc"]}`}, expected: false},
+		{name: "Batch", payload: &models.Payload{Data: `[{"method":"eth_getTransactionReceipt","params":["0xabc"]}]`}, expected: false},
+		{name: "RestRelay", payload: &models.Payload{Method: fasthttp.MethodGet, Path: "/v1/query/height"}, expected: false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.expected, isValidatableEvmRelay(tc.payload))
+		})
+	}
+}
+
 func TestRelayerTestSuite(t *testing.T) {
 	suite.Run(t, new(RelayerTestSuite))
 }
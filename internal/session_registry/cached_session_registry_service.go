@@ -316,13 +316,14 @@ func (c *CachedSessionRegistryService) exportNodeMetrics() {
 		var healthyNodesCount, syncedNodesCount, timeoutNodesCount int
 
 		for _, node := range sessionItem.Value() {
-			if node.IsHealthy() {
+			health := node.GetHealth()
+			if health.IsHealthy() {
 				healthyNodesCount++
 			}
-			if node.IsSynced() {
+			if health.Synced {
 				syncedNodesCount++
 			}
-			if node.IsInTimeout() {
+			if health.IsInTimeout() {
 				timeoutNodesCount++
 			}
 		}
@@ -0,0 +1,572 @@
+package websocket_relayer
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/fasthttp/websocket"
+	"github.com/pokt-network/gateway-server/internal/relay_usage_meter"
+	"go.uber.org/zap"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	upstreamDialTimeout       = time.Second * 10
+	upstreamMinReconnectDelay = time.Second * 1
+	upstreamMaxReconnectDelay = time.Second * 30
+	writeTimeout              = time.Second * 10
+	// messages queued for a client before it is considered too slow and disconnected
+	clientSendQueueSize = 256
+	// messages queued for the upstream before requests are rejected
+	upstreamSendQueueSize = 1024
+)
+
+const (
+	methodSubscribe    = "eth_subscribe"
+	methodUnsubscribe  = "eth_unsubscribe"
+	methodSubscription = "eth_subscription"
+)
+
+const (
+	jsonRpcInvalidRequestCode = -32600
+	jsonRpcInternalErrorCode  = -32603
+	jsonRpcLimitExceededCode  = -32005
+)
+
+var (
+	errUpstreamNotConfigured = errors.New("websocket upstream not configured")
+	errUpstreamClosed        = errors.New("websocket upstream closed")
+	errUpstreamUnavailable   = errors.New("websocket upstream unavailable")
+	errUpstreamDisconnected  = errors.New("websocket upstream disconnected")
+)
+
+// client - a websocket client, messages are queued and written by the client's own writer so that a slow client
+// cannot hold up the upstream or the other clients.
+type client struct {
+	conn            *websocket.Conn
+	chainId         string
+	caller          Caller
+	relayUsageMeter relay_usage_meter.RelayUsageMeterService
+	send            chan []byte
+	done            chan struct{}
+	closeOnce       sync.Once
+}
+
+func newClient(conn *websocket.Conn, chainId string, caller Caller, relayUsageMeter relay_usage_meter.RelayUsageMeterService) *client {
+	return &client{conn: conn, chainId: chainId, caller: caller, relayUsageMeter: relayUsageMeter, send: make(chan []byte, clientSendQueueSize), done: make(chan struct{})}
+}
+
+// recordRequest - meters an answered request of the client like a relay of its caller, websocket requests are always
+// served by the altruist.
+func (c *client) recordRequest(success bool, requestBytes int, responseBytes int) {
+	c.relayUsageMeter.RecordRelay(relay_usage_meter.RelayUsage{
+		CallerId:      c.caller.Id,
+		ChainId:       c.chainId,
+		Success:       success,
+		Altruist:      true,
+		RequestBytes:  requestBytes,
+		ResponseBytes: responseBytes,
+	})
+}
+
+// write - queues a message for the client, a client that does not keep up with its messages is disconnected.
+func (c *client) write(message []byte) {
+	select {
+	case <-c.done:
+	case c.send <- message:
+	default:
+		c.close()
+	}
+}
+
+// runWriter - writes the queued messages until the client is closed.
+func (c *client) runWriter() {
+	for {
+		select {
+		case <-c.done:
+			return
+		case message := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+func (c *client) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.conn.Close()
+	})
+}
+
+// upstreamConn - a connection to the altruist. Messages are queued and written by the connection's own writer, so the
+// upstream lock is not held during network writes.
+type upstreamConn struct {
+	conn *websocket.Conn
+	send chan []byte
+}
+
+func newUpstreamConn(conn *websocket.Conn) *upstreamConn {
+	return &upstreamConn{conn: conn, send: make(chan []byte, upstreamSendQueueSize)}
+}
+
+// runWriter - writes the queued messages until the send queue is closed, a failed write closes the connection so
+// that the upstream reconnects.
+func (c *upstreamConn) runWriter() {
+	for message := range c.send {
+		c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+			c.conn.Close()
+		}
+	}
+}
+
+type clientMessage struct {
+	client  *client
+	message []byte
+}
+
+type subscription struct {
+	// id known by the client, which is kept when the subscription is recreated on a new upstream connection
+	id string
+	// id of the subscription on the current upstream connection, empty while (re)subscribing
+	upstreamId string
+	client     *client
+	params     json.RawMessage
+}
+
+type pendingRequest struct {
+	client *client
+	// id of the client's request, restored on the upstream response
+	id           json.RawMessage
+	requestBytes int
+	subscription *subscription
+	// resubscribe requests are sent by the gateway after reconnecting, so their responses are not sent to the client
+	resubscribe bool
+}
+
+// upstream is a websocket connection to the chain's altruist that is shared by all the chain's clients.
+// Request ids are rewritten to be unique on the connection and subscriptions are recreated whenever the connection is lost.
+type upstream struct {
+	chainId string
+	getUrl  func() string
+	logger  *zap.Logger
+
+	lock                  sync.Mutex
+	conn                  *upstreamConn
+	nextRequestId         uint64
+	pendingRequests       map[uint64]*pendingRequest
+	subscriptions         map[string]*subscription // client subscription id > subscription
+	upstreamSubscriptions map[string]*subscription // upstream subscription id > subscription
+	clients               map[*client]bool
+	closed                bool
+
+	ready     chan struct{}
+	readyOnce sync.Once
+	done      chan struct{}
+}
+
+func newUpstream(chainId string, getUrl func() string, logger *zap.Logger) *upstream {
+	return &upstream{
+		chainId:               chainId,
+		getUrl:                getUrl,
+		logger:                logger,
+		pendingRequests:       map[uint64]*pendingRequest{},
+		subscriptions:         map[string]*subscription{},
+		upstreamSubscriptions: map[string]*subscription{},
+		clients:               map[*client]bool{},
+		ready:                 make(chan struct{}),
+		done:                  make(chan struct{}),
+	}
+}
+
+// run - keeps the upstream connected until it is closed, reconnecting with a backoff whenever the connection drops.
+func (u *upstream) run() {
+	reconnectDelay := upstreamMinReconnectDelay
+	for {
+		select {
+		case <-u.done:
+			return
+		default:
+		}
+		conn, err := u.connect()
+		u.readyOnce.Do(func() { close(u.ready) })
+		if errors.Is(err, errUpstreamClosed) {
+			return
+		}
+		counterWebsocketUpstreamConnect.WithLabelValues(strconv.FormatBool(err == nil), u.chainId).Inc()
+		if err != nil {
+			u.logger.Sugar().Warnw("failed to connect to websocket upstream", "chain", u.chainId, "err", err)
+			select {
+			case <-u.done:
+				return
+			case <-time.After(reconnectDelay):
+			}
+			reconnectDelay = min(reconnectDelay*2, upstreamMaxReconnectDelay)
+			continue
+		}
+		reconnectDelay = upstreamMinReconnectDelay
+		u.readMessages(conn.conn)
+		u.disconnect(conn)
+	}
+}
+
+func (u *upstream) waitUntilReady(timeout time.Duration) {
+	select {
+	case <-u.ready:
+	case <-time.After(timeout):
+	}
+}
+
+// connect - dials the upstream and recreates the existing subscriptions on the new connection.
+func (u *upstream) connect() (*upstreamConn, error) {
+	url := u.getUrl()
+	if url == "" {
+		return nil, errUpstreamNotConfigured
+	}
+	dialer := websocket.Dialer{HandshakeTimeout: upstreamDialTimeout}
+	wsConn, _, err := dialer.Dial(url, nil)
+	if err != nil {
+		return nil, err
+	}
+	conn := newUpstreamConn(wsConn)
+
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	if u.closed {
+		conn.conn.Close()
+		return nil, errUpstreamClosed
+	}
+	u.conn = conn
+	go conn.runWriter()
+	for _, sub := range u.subscriptions {
+		u.sendRequest(map[string]json.RawMessage{
+			"jsonrpc": json.RawMessage(`"2.0"`),
+			"method":  json.RawMessage(`"` + methodSubscribe + `"`),
+			"params":  sub.params,
+		}, &pendingRequest{client: sub.client, subscription: sub, resubscribe: true})
+	}
+	return conn, nil
+}
+
+// disconnect - drops the connection state and answers the in-flight requests of the clients with an error.
+func (u *upstream) disconnect(conn *upstreamConn) {
+	u.lock.Lock()
+	conn.conn.Close()
+	if u.conn == conn {
+		u.conn = nil
+		close(conn.send)
+	}
+	var messages []clientMessage
+	for _, pending := range u.pendingRequests {
+		if pending.resubscribe || pending.id == nil || !u.clients[pending.client] {
+			continue
+		}
+		message := newJsonRpcErrorMessage(pending.id, jsonRpcInternalErrorCode, errUpstreamDisconnected.Error())
+		pending.client.recordRequest(false, pending.requestBytes, len(message))
+		messages = append(messages, clientMessage{client: pending.client, message: message})
+	}
+	u.pendingRequests = map[uint64]*pendingRequest{}
+	u.upstreamSubscriptions = map[string]*subscription{}
+	for _, sub := range u.subscriptions {
+		sub.upstreamId = ""
+	}
+	u.lock.Unlock()
+
+	for _, m := range messages {
+		m.client.write(m.message)
+	}
+}
+
+func (u *upstream) close() {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	if u.closed {
+		return
+	}
+	u.closed = true
+	close(u.done)
+	if u.conn != nil {
+		u.conn.conn.Close()
+	}
+}
+
+func (u *upstream) addClient(c *client) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.clients[c] = true
+}
+
+// removeClient - removes the client and its subscriptions, returning the amount of clients left.
+func (u *upstream) removeClient(c *client) int {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	delete(u.clients, c)
+	for _, sub := range u.subscriptions {
+		if sub.client != c {
+			continue
+		}
+		u.removeSubscription(sub)
+		if sub.upstreamId != "" {
+			u.sendUnsubscribe(sub.upstreamId)
+		}
+	}
+	return len(u.clients)
+}
+
+// handleClientMessage - forwards a client message to the upstream, answering the client directly if it cannot be forwarded.
+func (u *upstream) handleClientMessage(c *client, message []byte) {
+	var request map[string]json.RawMessage
+	if err := json.Unmarshal(message, &request); err != nil {
+		c.write(newJsonRpcErrorMessage(nil, jsonRpcInvalidRequestCode, "invalid request"))
+		return
+	}
+	if c.caller.AllowRequest != nil {
+		if err := c.caller.AllowRequest(); err != nil {
+			c.write(newJsonRpcErrorMessage(request["id"], jsonRpcLimitExceededCode, err.Error()))
+			return
+		}
+	}
+
+	u.lock.Lock()
+	response, err := u.forwardClientRequest(c, request, len(message))
+	u.lock.Unlock()
+
+	// Forwarded requests are metered once they are answered, notifications do not have an answer
+	if response != nil || request["id"] == nil {
+		c.recordRequest(err == nil, len(message), len(response))
+	}
+	if response != nil {
+		c.write(response)
+	}
+}
+
+// forwardClientRequest - sends the client's request upstream, returns a response if the client needs to be answered
+// directly and an error if the request failed.
+func (u *upstream) forwardClientRequest(c *client, request map[string]json.RawMessage, requestBytes int) ([]byte, error) {
+	id := request["id"]
+	if u.conn == nil {
+		return newJsonRpcErrorMessage(id, jsonRpcInternalErrorCode, errUpstreamUnavailable.Error()), errUpstreamUnavailable
+	}
+	// Notifications do not have a response to route back
+	if id == nil {
+		return nil, u.write(request)
+	}
+
+	pending := &pendingRequest{client: c, id: id, requestBytes: requestBytes}
+	var method string
+	json.Unmarshal(request["method"], &method)
+	switch method {
+	case methodSubscribe:
+		pending.subscription = &subscription{id: newSubscriptionId(), client: c, params: request["params"]}
+	case methodUnsubscribe:
+		sub := u.getClientSubscription(c, request["params"])
+		if sub == nil {
+			return newJsonRpcResultMessage(id, false), nil
+		}
+		u.removeSubscription(sub)
+		if sub.upstreamId == "" {
+			// Still resubscribing, the upstream subscription is removed once it is created
+			return newJsonRpcResultMessage(id, true), nil
+		}
+		request["params"], _ = json.Marshal([]string{sub.upstreamId})
+	}
+	if err := u.sendRequest(request, pending); err != nil {
+		return newJsonRpcErrorMessage(id, jsonRpcInternalErrorCode, errUpstreamUnavailable.Error()), err
+	}
+	return nil, nil
+}
+
+// handleUpstreamMessage - routes an upstream response or subscription notification to its client.
+func (u *upstream) handleUpstreamMessage(message []byte) {
+	var response map[string]json.RawMessage
+	if err := json.Unmarshal(message, &response); err != nil {
+		u.logger.Sugar().Warnw("invalid message from websocket upstream", "chain", u.chainId, "err", err)
+		return
+	}
+
+	u.lock.Lock()
+	c, clientMessage := u.routeUpstreamMessage(response)
+	u.lock.Unlock()
+
+	if c != nil {
+		c.write(clientMessage)
+	}
+}
+
+func (u *upstream) routeUpstreamMessage(response map[string]json.RawMessage) (*client, []byte) {
+	if id, ok := response["id"]; ok {
+		requestId, err := strconv.ParseUint(string(id), 10, 64)
+		if err != nil {
+			return nil, nil
+		}
+		pending, ok := u.pendingRequests[requestId]
+		if !ok {
+			return nil, nil
+		}
+		delete(u.pendingRequests, requestId)
+		return u.routeResponse(pending, response)
+	}
+
+	var method string
+	json.Unmarshal(response["method"], &method)
+	if method == methodSubscription {
+		return u.routeSubscriptionNotification(response)
+	}
+	return nil, nil
+}
+
+func (u *upstream) routeResponse(pending *pendingRequest, response map[string]json.RawMessage) (*client, []byte) {
+	if sub := pending.subscription; sub != nil {
+		var upstreamId string
+		if err := json.Unmarshal(response["result"], &upstreamId); err != nil || upstreamId == "" {
+			if pending.resubscribe {
+				u.logger.Sugar().Warnw("failed to resubscribe on websocket upstream", "chain", u.chainId, "response", response["error"])
+				u.removeSubscription(sub)
+				return nil, nil
+			}
+			// Subscription failed, the error is returned to the client as is
+			sub = nil
+		} else if !u.clients[pending.client] || (pending.resubscribe && u.subscriptions[sub.id] != sub) {
+			// Client disconnected or unsubscribed before the subscription was created
+			u.sendUnsubscribe(upstreamId)
+			return nil, nil
+		} else {
+			sub.upstreamId = upstreamId
+			u.subscriptions[sub.id] = sub
+			u.upstreamSubscriptions[upstreamId] = sub
+			if pending.resubscribe {
+				return nil, nil
+			}
+			response["result"], _ = json.Marshal(sub.id)
+		}
+	}
+	response["id"] = pending.id
+	message, _ := json.Marshal(response)
+	pending.client.recordRequest(true, pending.requestBytes, len(message))
+	if !u.clients[pending.client] {
+		return nil, nil
+	}
+	return pending.client, message
+}
+
+func (u *upstream) routeSubscriptionNotification(notification map[string]json.RawMessage) (*client, []byte) {
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(notification["params"], &params); err != nil {
+		return nil, nil
+	}
+	var upstreamId string
+	json.Unmarshal(params["subscription"], &upstreamId)
+	sub, ok := u.upstreamSubscriptions[upstreamId]
+	if !ok {
+		return nil, nil
+	}
+	params["subscription"], _ = json.Marshal(sub.id)
+	notification["params"], _ = json.Marshal(params)
+	message, _ := json.Marshal(notification)
+	return sub.client, message
+}
+
+// getClientSubscription - returns the client's subscription referenced by eth_unsubscribe params.
+func (u *upstream) getClientSubscription(c *client, params json.RawMessage) *subscription {
+	var ids []string
+	if err := json.Unmarshal(params, &ids); err != nil || len(ids) == 0 {
+		return nil
+	}
+	sub, ok := u.subscriptions[ids[0]]
+	if !ok || sub.client != c {
+		return nil
+	}
+	return sub
+}
+
+func (u *upstream) removeSubscription(sub *subscription) {
+	delete(u.subscriptions, sub.id)
+	if sub.upstreamId != "" {
+		delete(u.upstreamSubscriptions, sub.upstreamId)
+	}
+}
+
+// sendUnsubscribe - removes a subscription from the upstream, the response is ignored.
+func (u *upstream) sendUnsubscribe(upstreamId string) {
+	params, _ := json.Marshal([]string{upstreamId})
+	u.sendRequest(map[string]json.RawMessage{
+		"jsonrpc": json.RawMessage(`"2.0"`),
+		"method":  json.RawMessage(`"` + methodUnsubscribe + `"`),
+		"params":  params,
+	}, nil)
+}
+
+// sendRequest - sends a request with an id that is unique on the connection. If pending is provided, the response is routed using it.
+func (u *upstream) sendRequest(request map[string]json.RawMessage, pending *pendingRequest) error {
+	if u.conn == nil {
+		return errUpstreamUnavailable
+	}
+	u.nextRequestId++
+	request["id"] = json.RawMessage(strconv.FormatUint(u.nextRequestId, 10))
+	if err := u.write(request); err != nil {
+		return err
+	}
+	if pending != nil {
+		u.pendingRequests[u.nextRequestId] = pending
+	}
+	return nil
+}
+
+// write - queues the request on the current connection, requests are rejected while the send queue is full.
+func (u *upstream) write(request map[string]json.RawMessage) error {
+	message, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	select {
+	case u.conn.send <- message:
+		return nil
+	default:
+		return errUpstreamUnavailable
+	}
+}
+
+func (u *upstream) readMessages(conn *websocket.Conn) {
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			u.logger.Sugar().Debugw("websocket upstream disconnected", "chain", u.chainId, "err", err)
+			return
+		}
+		u.handleUpstreamMessage(message)
+	}
+}
+
+func newSubscriptionId() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return "0x" + hex.EncodeToString(id)
+}
+
+func newJsonRpcErrorMessage(id json.RawMessage, code int, message string) []byte {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	rsp, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"error":   map[string]any{"code": code, "message": message},
+	})
+	return rsp
+}
+
+func newJsonRpcResultMessage(id json.RawMessage, result any) []byte {
+	rsp, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"result":  result,
+	})
+	return rsp
+}
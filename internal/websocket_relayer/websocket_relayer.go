@@ -0,0 +1,121 @@
+package websocket_relayer
+
+import (
+	"github.com/fasthttp/websocket"
+	"github.com/pokt-network/gateway-server/internal/chain_configurations_registry"
+	"github.com/pokt-network/gateway-server/internal/relay_usage_meter"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"sync"
+)
+
+var (
+	gaugeWebsocketClients           *prometheus.GaugeVec
+	counterWebsocketUpstreamConnect *prometheus.CounterVec
+)
+
+func init() {
+	gaugeWebsocketClients = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "websocket_clients",
+			Help: "Websocket clients connected to the gateway",
+		},
+		[]string{"chain_id"},
+	)
+	counterWebsocketUpstreamConnect = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "websocket_upstream_connect_counter",
+			Help: "Websocket upstream connection attempts and if they succeeded",
+		},
+		[]string{"success", "chain_id"},
+	)
+	prometheus.MustRegister(gaugeWebsocketClients)
+	prometheus.MustRegister(counterWebsocketUpstreamConnect)
+}
+
+// Caller - the caller of a websocket client, every request of the client is limited and metered like a relay of the caller.
+type Caller struct {
+	// Id relay usage is metered by, empty for anonymous callers
+	Id string
+	// AllowRequest is called for every request, requests it returns an error for are answered with the error. Callers
+	// without limits leave it nil.
+	AllowRequest func() error
+}
+
+// WebsocketRelayer relays JSON-RPC websocket clients, such as eth_subscribe subscriptions, to the chain's altruist websocket.
+// Morse relays cannot carry websocket traffic, so all clients of a chain are multiplexed over one shared upstream connection.
+type WebsocketRelayer struct {
+	chainConfigurationRegistry chain_configurations_registry.ChainConfigurationsService
+	relayUsageMeter            relay_usage_meter.RelayUsageMeterService
+	upstreams                  map[string]*upstream
+	upstreamsLock              sync.Mutex
+	logger                     *zap.Logger
+}
+
+func NewWebsocketRelayer(chainConfigurationRegistry chain_configurations_registry.ChainConfigurationsService, relayUsageMeter relay_usage_meter.RelayUsageMeterService, logger *zap.Logger) *WebsocketRelayer {
+	return &WebsocketRelayer{
+		chainConfigurationRegistry: chainConfigurationRegistry,
+		relayUsageMeter:            relayUsageMeter,
+		upstreams:                  map[string]*upstream{},
+		logger:                     logger,
+	}
+}
+
+// IsChainSupported - returns whether the chain has a websocket upstream configured.
+func (r *WebsocketRelayer) IsChainSupported(chainId string) bool {
+	return r.getUpstreamUrl(chainId) != ""
+}
+
+// ServeClient - relays the client's messages over the chain's shared upstream connection until the client disconnects.
+func (r *WebsocketRelayer) ServeClient(chainId string, conn *websocket.Conn, caller Caller) {
+	c := newClient(conn, chainId, caller, r.relayUsageMeter)
+	go c.runWriter()
+	defer c.close()
+	u := r.acquireUpstream(chainId, c)
+	defer r.releaseUpstream(chainId, u, c)
+
+	gaugeWebsocketClients.WithLabelValues(chainId).Inc()
+	defer gaugeWebsocketClients.WithLabelValues(chainId).Dec()
+
+	// Give a new upstream the chance to connect before handling the client's first messages
+	u.waitUntilReady(upstreamDialTimeout)
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		u.handleClientMessage(c, message)
+	}
+}
+
+// acquireUpstream - returns the chain's upstream with the client registered, starting the upstream if the chain does not have one yet.
+func (r *WebsocketRelayer) acquireUpstream(chainId string, c *client) *upstream {
+	r.upstreamsLock.Lock()
+	defer r.upstreamsLock.Unlock()
+	u, ok := r.upstreams[chainId]
+	if !ok {
+		u = newUpstream(chainId, func() string { return r.getUpstreamUrl(chainId) }, r.logger)
+		r.upstreams[chainId] = u
+		go u.run()
+	}
+	u.addClient(c)
+	return u
+}
+
+// releaseUpstream - removes the client from the upstream, closing the upstream once it has no clients left.
+func (r *WebsocketRelayer) releaseUpstream(chainId string, u *upstream, c *client) {
+	r.upstreamsLock.Lock()
+	defer r.upstreamsLock.Unlock()
+	if u.removeClient(c) == 0 {
+		delete(r.upstreams, chainId)
+		u.close()
+	}
+}
+
+func (r *WebsocketRelayer) getUpstreamUrl(chainId string) string {
+	chainConfig, ok := r.chainConfigurationRegistry.GetChainConfiguration(chainId)
+	if !ok {
+		return ""
+	}
+	return chainConfig.AltruistWebsocketUrl.String
+}
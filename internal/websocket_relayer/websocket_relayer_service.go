@@ -0,0 +1,8 @@
+package websocket_relayer
+
+import "github.com/fasthttp/websocket"
+
+type WebsocketRelayerService interface {
+	IsChainSupported(chainId string) bool
+	ServeClient(chainId string, conn *websocket.Conn, caller Caller)
+}
@@ -0,0 +1,248 @@
+package websocket_relayer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/fasthttp/websocket"
+	"github.com/jackc/pgtype"
+	"github.com/pokt-network/gateway-server/internal/db_query"
+	"github.com/pokt-network/gateway-server/internal/relay_usage_meter"
+	chain_configurations_registry_mock "github.com/pokt-network/gateway-server/mocks/chain_configurations_registry"
+	relay_usage_meter_mock "github.com/pokt-network/gateway-server/mocks/relay_usage_meter"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeUpstream is a JSON-RPC websocket server that answers every request with its method and
+// subscriptions with an id unique to the server.
+type fakeUpstream struct {
+	server        *httptest.Server
+	conns         chan *websocket.Conn
+	subscriptions atomic.Int64
+	// amount of subscription responses written, notifications are only sent afterward
+	subscribed atomic.Int64
+	writeLock  sync.Mutex
+}
+
+func newFakeUpstream() *fakeUpstream {
+	f := &fakeUpstream{conns: make(chan *websocket.Conn, 10)}
+	upgrader := websocket.Upgrader{}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		f.conns <- conn
+		for {
+			var request map[string]json.RawMessage
+			if err := conn.ReadJSON(&request); err != nil {
+				return
+			}
+			var method string
+			json.Unmarshal(request["method"], &method)
+			var result any = method
+			if method == methodSubscribe {
+				result = fmt.Sprintf("0xupstream%d", f.subscriptions.Add(1))
+			}
+			f.writeLock.Lock()
+			conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": request["id"], "result": result})
+			f.writeLock.Unlock()
+			if method == methodSubscribe {
+				f.subscribed.Add(1)
+			}
+		}
+	}))
+	return f
+}
+
+func (f *fakeUpstream) url() string {
+	return "ws" + strings.TrimPrefix(f.server.URL, "http")
+}
+
+func (f *fakeUpstream) notify(conn *websocket.Conn, subscriptionId string, result string) {
+	f.writeLock.Lock()
+	defer f.writeLock.Unlock()
+	conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "method": methodSubscription, "params": map[string]any{"subscription": subscriptionId, "result": result}})
+}
+
+type WebsocketRelayerTestSuite struct {
+	suite.Suite
+	mockChainConfigurationsService *chain_configurations_registry_mock.ChainConfigurationsService
+	mockRelayUsageMeter            *relay_usage_meter_mock.RelayUsageMeterService
+	upstream                       *fakeUpstream
+	gateway                        *httptest.Server
+	relayer                        *WebsocketRelayer
+	// requests allowed before client requests are rejected
+	allowedRequests atomic.Int64
+	usage           chan relay_usage_meter.RelayUsage
+}
+
+func (suite *WebsocketRelayerTestSuite) allowRequest() error {
+	if suite.allowedRequests.Add(-1) < 0 {
+		return errors.New("rate limit exceeded")
+	}
+	return nil
+}
+
+func (suite *WebsocketRelayerTestSuite) SetupTest() {
+	suite.upstream = newFakeUpstream()
+	websocketUrl := pgtype.Varchar{}
+	websocketUrl.Set(suite.upstream.url())
+
+	suite.mockChainConfigurationsService = new(chain_configurations_registry_mock.ChainConfigurationsService)
+	suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("1234").Return(db_query.GetChainConfigurationsRow{AltruistWebsocketUrl: websocketUrl}, true).Maybe()
+	suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("5678").Return(db_query.GetChainConfigurationsRow{}, false).Maybe()
+	suite.mockChainConfigurationsService.EXPECT().GetChainConfiguration("4321").Return(db_query.GetChainConfigurationsRow{ChainFamily: pgtype.Varchar{String: "evm", Status: pgtype.Present}}, true).Maybe()
+	suite.allowedRequests.Store(100)
+	suite.usage = make(chan relay_usage_meter.RelayUsage, 100)
+	suite.mockRelayUsageMeter = new(relay_usage_meter_mock.RelayUsageMeterService)
+	suite.mockRelayUsageMeter.EXPECT().RecordRelay(mock.Anything).Run(func(usage relay_usage_meter.RelayUsage) {
+		suite.usage <- usage
+	}).Maybe()
+	suite.relayer = NewWebsocketRelayer(suite.mockChainConfigurationsService, suite.mockRelayUsageMeter, zap.NewNop())
+
+	upgrader := websocket.Upgrader{}
+	suite.gateway = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		suite.relayer.ServeClient("1234", conn, Caller{Id: "caller", AllowRequest: suite.allowRequest})
+	}))
+}
+
+func (suite *WebsocketRelayerTestSuite) TearDownTest() {
+	suite.gateway.Close()
+	suite.upstream.server.Close()
+}
+
+func (suite *WebsocketRelayerTestSuite) dialGateway() *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(suite.gateway.URL, "http"), nil)
+	suite.Require().NoError(err)
+	return conn
+}
+
+func (suite *WebsocketRelayerTestSuite) readMessage(conn *websocket.Conn) map[string]any {
+	conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	var message map[string]any
+	suite.Require().NoError(conn.ReadJSON(&message))
+	return message
+}
+
+func (suite *WebsocketRelayerTestSuite) TestIsChainSupported() {
+	suite.True(suite.relayer.IsChainSupported("1234"))
+	// EVM chains are only supported once they have an altruist websocket
+	suite.False(suite.relayer.IsChainSupported("4321"))
+	suite.False(suite.relayer.IsChainSupported("5678"))
+}
+
+func (suite *WebsocketRelayerTestSuite) TestRequestIdsAreRestored() {
+	first := suite.dialGateway()
+	defer first.Close()
+	second := suite.dialGateway()
+	defer second.Close()
+
+	// Both clients use the same id, which must not collide on the shared upstream connection
+	suite.Require().NoError(first.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 1, "method": "eth_blockNumber"}))
+	suite.Require().NoError(second.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 1, "method": "eth_chainId"}))
+
+	firstResponse := suite.readMessage(first)
+	suite.Equal(float64(1), firstResponse["id"])
+	suite.Equal("eth_blockNumber", firstResponse["result"])
+
+	secondResponse := suite.readMessage(second)
+	suite.Equal(float64(1), secondResponse["id"])
+	suite.Equal("eth_chainId", secondResponse["result"])
+}
+
+func (suite *WebsocketRelayerTestSuite) TestSubscriptionSurvivesReconnect() {
+	client := suite.dialGateway()
+	defer client.Close()
+
+	suite.Require().NoError(client.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": "sub", "method": methodSubscribe, "params": []string{"newHeads"}}))
+	response := suite.readMessage(client)
+	suite.Equal("sub", response["id"])
+	subscriptionId, ok := response["result"].(string)
+	suite.Require().True(ok)
+	suite.NotEqual("0xupstream1", subscriptionId)
+
+	upstreamConn := <-suite.upstream.conns
+	suite.upstream.notify(upstreamConn, "0xupstream1", "head1")
+	notification := suite.readMessage(client)
+	suite.Equal(methodSubscription, notification["method"])
+	suite.Equal(map[string]any{"subscription": subscriptionId, "result": "head1"}, notification["params"])
+
+	// Dropping the upstream recreates the subscription on a new connection, keeping the client's subscription id
+	upstreamConn.Close()
+	upstreamConn = <-suite.upstream.conns
+	suite.Eventually(func() bool { return suite.upstream.subscribed.Load() == 2 }, time.Second*5, time.Millisecond*10)
+	suite.upstream.notify(upstreamConn, "0xupstream2", "head2")
+	notification = suite.readMessage(client)
+	suite.Equal(map[string]any{"subscription": subscriptionId, "result": "head2"}, notification["params"])
+
+	// Unsubscribing is translated to the upstream subscription
+	suite.Require().NoError(client.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 2, "method": methodUnsubscribe, "params": []string{subscriptionId}}))
+	response = suite.readMessage(client)
+	suite.Equal(float64(2), response["id"])
+	suite.Equal(methodUnsubscribe, response["result"])
+
+	// Unknown subscriptions are answered by the gateway
+	suite.Require().NoError(client.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 3, "method": methodUnsubscribe, "params": []string{subscriptionId}}))
+	response = suite.readMessage(client)
+	suite.Equal(float64(3), response["id"])
+	suite.Equal(false, response["result"])
+}
+
+func (suite *WebsocketRelayerTestSuite) TestRequestsAreLimited() {
+	suite.allowedRequests.Store(1)
+	client := suite.dialGateway()
+	defer client.Close()
+
+	suite.Require().NoError(client.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 1, "method": "eth_blockNumber"}))
+	response := suite.readMessage(client)
+	suite.Equal("eth_blockNumber", response["result"])
+
+	// Every request is counted, not only the connection
+	suite.Require().NoError(client.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 2, "method": "eth_blockNumber"}))
+	response = suite.readMessage(client)
+	suite.Equal(float64(2), response["id"])
+	suite.Equal(map[string]any{"code": float64(jsonRpcLimitExceededCode), "message": "rate limit exceeded"}, response["error"])
+}
+
+func (suite *WebsocketRelayerTestSuite) TestRequestsAreMetered() {
+	client := suite.dialGateway()
+	defer client.Close()
+
+	request := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`
+	suite.Require().NoError(client.WriteMessage(websocket.TextMessage, []byte(request)))
+	response := suite.readMessage(client)
+	suite.Equal("eth_blockNumber", response["result"])
+
+	responseBytes, _ := json.Marshal(response)
+	usage := <-suite.usage
+	suite.Equal(relay_usage_meter.RelayUsage{CallerId: "caller", ChainId: "1234", Success: true, Altruist: true, RequestBytes: len(request), ResponseBytes: len(responseBytes)}, usage)
+}
+
+func (suite *WebsocketRelayerTestSuite) TestInvalidRequest() {
+	client := suite.dialGateway()
+	defer client.Close()
+
+	suite.Require().NoError(client.WriteMessage(websocket.TextMessage, []byte("not json")))
+	response := suite.readMessage(client)
+	suite.Nil(response["id"])
+	suite.Equal(float64(jsonRpcInvalidRequestCode), response["error"].(map[string]any)["code"])
+}
+
+func TestWebsocketRelayerTestSuite(t *testing.T) {
+	suite.Run(t, new(WebsocketRelayerTestSuite))
+}
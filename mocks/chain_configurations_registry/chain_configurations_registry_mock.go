@@ -76,6 +76,51 @@ func (_c *ChainConfigurationsService_GetChainConfiguration_Call) RunAndReturn(ru
 	return _c
 }
 
+// Refresh provides a mock function with given fields:
+func (_m *ChainConfigurationsService) Refresh() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+// ChainConfigurationsService_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type ChainConfigurationsService_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+func (_e *ChainConfigurationsService_Expecter) Refresh() *ChainConfigurationsService_Refresh_Call {
+	return &ChainConfigurationsService_Refresh_Call{Call: _e.mock.On("Refresh")}
+}
+
+func (_c *ChainConfigurationsService_Refresh_Call) Run(run func()) *ChainConfigurationsService_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ChainConfigurationsService_Refresh_Call) Return(_a0 error) *ChainConfigurationsService_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChainConfigurationsService_Refresh_Call) RunAndReturn(run func() error) *ChainConfigurationsService_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewChainConfigurationsService creates a new instance of ChainConfigurationsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewChainConfigurationsService(t interface {
@@ -3,7 +3,6 @@
 package global_config_mock
 
 import (
-	chain_network "github.com/pokt-network/gateway-server/internal/chain_network"
 	global_config "github.com/pokt-network/gateway-server/internal/global_config"
 
 	mock "github.com/stretchr/testify/mock"
@@ -114,51 +113,6 @@ func (_c *GlobalConfigProvider_GetAltruistRequestTimeout_Call) RunAndReturn(run
 	return _c
 }
 
-// GetChainNetwork provides a mock function with given fields:
-func (_m *GlobalConfigProvider) GetChainNetwork() chain_network.ChainNetwork {
-	ret := _m.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetChainNetwork")
-	}
-
-	var r0 chain_network.ChainNetwork
-	if rf, ok := ret.Get(0).(func() chain_network.ChainNetwork); ok {
-		r0 = rf()
-	} else {
-		r0 = ret.Get(0).(chain_network.ChainNetwork)
-	}
-
-	return r0
-}
-
-// GlobalConfigProvider_GetChainNetwork_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChainNetwork'
-type GlobalConfigProvider_GetChainNetwork_Call struct {
-	*mock.Call
-}
-
-// GetChainNetwork is a helper method to define mock.On call
-func (_e *GlobalConfigProvider_Expecter) GetChainNetwork() *GlobalConfigProvider_GetChainNetwork_Call {
-	return &GlobalConfigProvider_GetChainNetwork_Call{Call: _e.mock.On("GetChainNetwork")}
-}
-
-func (_c *GlobalConfigProvider_GetChainNetwork_Call) Run(run func()) *GlobalConfigProvider_GetChainNetwork_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
-	return _c
-}
-
-func (_c *GlobalConfigProvider_GetChainNetwork_Call) Return(_a0 chain_network.ChainNetwork) *GlobalConfigProvider_GetChainNetwork_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *GlobalConfigProvider_GetChainNetwork_Call) RunAndReturn(run func() chain_network.ChainNetwork) *GlobalConfigProvider_GetChainNetwork_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // GetDatabaseConnectionUrl provides a mock function with given fields:
 func (_m *GlobalConfigProvider) GetDatabaseConnectionUrl() string {
 	ret := _m.Called()
@@ -384,6 +338,98 @@ func (_c *GlobalConfigProvider_GetPoktRPCRequestTimeout_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetRelayForwardedHeaders provides a mock function with given fields:
+func (_m *GlobalConfigProvider) GetRelayForwardedHeaders() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelayForwardedHeaders")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// GlobalConfigProvider_GetRelayForwardedHeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRelayForwardedHeaders'
+type GlobalConfigProvider_GetRelayForwardedHeaders_Call struct {
+	*mock.Call
+}
+
+// GetRelayForwardedHeaders is a helper method to define mock.On call
+func (_e *GlobalConfigProvider_Expecter) GetRelayForwardedHeaders() *GlobalConfigProvider_GetRelayForwardedHeaders_Call {
+	return &GlobalConfigProvider_GetRelayForwardedHeaders_Call{Call: _e.mock.On("GetRelayForwardedHeaders")}
+}
+
+func (_c *GlobalConfigProvider_GetRelayForwardedHeaders_Call) Run(run func()) *GlobalConfigProvider_GetRelayForwardedHeaders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GlobalConfigProvider_GetRelayForwardedHeaders_Call) Return(_a0 []string) *GlobalConfigProvider_GetRelayForwardedHeaders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GlobalConfigProvider_GetRelayForwardedHeaders_Call) RunAndReturn(run func() []string) *GlobalConfigProvider_GetRelayForwardedHeaders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsRelayAPIKeyRequired provides a mock function with given fields:
+func (_m *GlobalConfigProvider) IsRelayAPIKeyRequired() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsRelayAPIKeyRequired")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// GlobalConfigProvider_IsRelayAPIKeyRequired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsRelayAPIKeyRequired'
+type GlobalConfigProvider_IsRelayAPIKeyRequired_Call struct {
+	*mock.Call
+}
+
+// IsRelayAPIKeyRequired is a helper method to define mock.On call
+func (_e *GlobalConfigProvider_Expecter) IsRelayAPIKeyRequired() *GlobalConfigProvider_IsRelayAPIKeyRequired_Call {
+	return &GlobalConfigProvider_IsRelayAPIKeyRequired_Call{Call: _e.mock.On("IsRelayAPIKeyRequired")}
+}
+
+func (_c *GlobalConfigProvider_IsRelayAPIKeyRequired_Call) Run(run func()) *GlobalConfigProvider_IsRelayAPIKeyRequired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GlobalConfigProvider_IsRelayAPIKeyRequired_Call) Return(_a0 bool) *GlobalConfigProvider_IsRelayAPIKeyRequired_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GlobalConfigProvider_IsRelayAPIKeyRequired_Call) RunAndReturn(run func() bool) *GlobalConfigProvider_IsRelayAPIKeyRequired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShouldEmitServiceUrlPromMetrics provides a mock function with given fields:
 func (_m *GlobalConfigProvider) ShouldEmitServiceUrlPromMetrics() bool {
 	ret := _m.Called()
@@ -5,6 +5,8 @@ package node_selector_mock
 import (
 	models "github.com/pokt-network/gateway-server/internal/node_selector_service/models"
 	mock "github.com/stretchr/testify/mock"
+
+	node_selector_service "github.com/pokt-network/gateway-server/internal/node_selector_service"
 )
 
 // NodeSelectorService is an autogenerated mock type for the NodeSelectorService type
@@ -20,9 +22,16 @@ func (_m *NodeSelectorService) EXPECT() *NodeSelectorService_Expecter {
 	return &NodeSelectorService_Expecter{mock: &_m.Mock}
 }
 
-// FindNode provides a mock function with given fields: chainId
-func (_m *NodeSelectorService) FindNode(chainId string) (*models.QosNode, bool) {
-	ret := _m.Called(chainId)
+// FindNode provides a mock function with given fields: chainId, filters
+func (_m *NodeSelectorService) FindNode(chainId string, filters ...node_selector_service.NodeFilter) (*models.QosNode, bool) {
+	_va := make([]interface{}, len(filters))
+	for _i := range filters {
+		_va[_i] = filters[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, chainId)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindNode")
@@ -30,19 +39,19 @@ func (_m *NodeSelectorService) FindNode(chainId string) (*models.QosNode, bool)
 
 	var r0 *models.QosNode
 	var r1 bool
-	if rf, ok := ret.Get(0).(func(string) (*models.QosNode, bool)); ok {
-		return rf(chainId)
+	if rf, ok := ret.Get(0).(func(string, ...node_selector_service.NodeFilter) (*models.QosNode, bool)); ok {
+		return rf(chainId, filters...)
 	}
-	if rf, ok := ret.Get(0).(func(string) *models.QosNode); ok {
-		r0 = rf(chainId)
+	if rf, ok := ret.Get(0).(func(string, ...node_selector_service.NodeFilter) *models.QosNode); ok {
+		r0 = rf(chainId, filters...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.QosNode)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) bool); ok {
-		r1 = rf(chainId)
+	if rf, ok := ret.Get(1).(func(string, ...node_selector_service.NodeFilter) bool); ok {
+		r1 = rf(chainId, filters...)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
@@ -57,13 +66,21 @@ type NodeSelectorService_FindNode_Call struct {
 
 // FindNode is a helper method to define mock.On call
 //   - chainId string
-func (_e *NodeSelectorService_Expecter) FindNode(chainId interface{}) *NodeSelectorService_FindNode_Call {
-	return &NodeSelectorService_FindNode_Call{Call: _e.mock.On("FindNode", chainId)}
+//   - filters ...node_selector_service.NodeFilter
+func (_e *NodeSelectorService_Expecter) FindNode(chainId interface{}, filters ...interface{}) *NodeSelectorService_FindNode_Call {
+	return &NodeSelectorService_FindNode_Call{Call: _e.mock.On("FindNode",
+		append([]interface{}{chainId}, filters...)...)}
 }
 
-func (_c *NodeSelectorService_FindNode_Call) Run(run func(chainId string)) *NodeSelectorService_FindNode_Call {
+func (_c *NodeSelectorService_FindNode_Call) Run(run func(chainId string, filters ...node_selector_service.NodeFilter)) *NodeSelectorService_FindNode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		variadicArgs := make([]node_selector_service.NodeFilter, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(node_selector_service.NodeFilter)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
@@ -73,7 +90,7 @@ func (_c *NodeSelectorService_FindNode_Call) Return(_a0 *models.QosNode, _a1 boo
 	return _c
 }
 
-func (_c *NodeSelectorService_FindNode_Call) RunAndReturn(run func(string) (*models.QosNode, bool)) *NodeSelectorService_FindNode_Call {
+func (_c *NodeSelectorService_FindNode_Call) RunAndReturn(run func(string, ...node_selector_service.NodeFilter) (*models.QosNode, bool)) *NodeSelectorService_FindNode_Call {
 	_c.Call.Return(run)
 	return _c
 }
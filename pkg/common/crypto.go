@@ -29,3 +29,9 @@ func GetAddressFromPublicKey(publicKey string) (string, error) {
 	address := hex.EncodeToString(hashBytes)
 	return address[:40], nil
 }
+
+// Sha256HashHex returns the hex encoded sha256 hash of the string, such as the hash of an api key stored in the database.
+func Sha256HashHex(data string) string {
+	hash := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(hash[:])
+}
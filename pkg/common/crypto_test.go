@@ -144,3 +144,31 @@ func TestGetAddressFromPublicKey(t *testing.T) {
 	}
 
 }
+
+// test for Sha256HashHex function in pkg/common/crypto.go file
+func TestSha256HashHex(t *testing.T) {
+
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "Sha256_Payload1",
+			data: "test1",
+			want: "1b4f0e9851971998e732078544c96b36c3d01cedf7caa332359d6f1d83567014",
+		},
+		{
+			name: "Sha256_EmptyPayload",
+			data: "",
+			want: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Sha256HashHex(tt.data))
+		})
+	}
+
+}
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JsonPath is a parsed JSONPath expression. Only the subset needed to address a single value is supported: the root
+// `$`, child members `.name` or `['name']` and array indices `[0]`, where negative indices count from the end.
+type JsonPath []jsonPathSegment
+
+type jsonPathSegment struct {
+	member string
+	index  int
+	// isIndex is set for array indices, since an empty member is a valid key
+	isIndex bool
+}
+
+// ParseJsonPath - parses a JSONPath expression such as `$.result.sync_info['latest_block_height']` or `$.result[0].hash`.
+func ParseJsonPath(path string) (JsonPath, error) {
+	remaining, ok := strings.CutPrefix(path, "$")
+	if !ok {
+		return nil, fmt.Errorf("json path %s must start with $", path)
+	}
+	var jsonPath JsonPath
+	for remaining != "" {
+		switch remaining[0] {
+		case '.':
+			end := strings.IndexAny(remaining[1:], ".[")
+			if end < 0 {
+				end = len(remaining) - 1
+			}
+			member := remaining[1 : end+1]
+			if member == "" {
+				return nil, fmt.Errorf("json path %s has an empty member", path)
+			}
+			jsonPath = append(jsonPath, jsonPathSegment{member: member})
+			remaining = remaining[end+1:]
+		case '[':
+			end := strings.IndexByte(remaining, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("json path %s has an unclosed bracket", path)
+			}
+			segment, err := parseJsonPathBracket(remaining[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("json path %s: %w", path, err)
+			}
+			jsonPath = append(jsonPath, segment)
+			remaining = remaining[end+1:]
+		default:
+			return nil, fmt.Errorf("json path %s has an unexpected character %q", path, remaining[0])
+		}
+	}
+	return jsonPath, nil
+}
+
+func parseJsonPathBracket(content string) (jsonPathSegment, error) {
+	if len(content) >= 2 && (content[0] == '\'' || content[0] == '"') && content[len(content)-1] == content[0] {
+		return jsonPathSegment{member: content[1 : len(content)-1]}, nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return jsonPathSegment{}, fmt.Errorf("invalid index %s", content)
+	}
+	return jsonPathSegment{index: index, isIndex: true}, nil
+}
+
+// Extract - returns the value at the path of the JSON document. Numbers are returned as json.Number to keep their precision.
+func (p JsonPath) Extract(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	for _, segment := range p {
+		if segment.isIndex {
+			array, ok := value.([]any)
+			if !ok {
+				return nil, fmt.Errorf("cannot index %T", value)
+			}
+			index := segment.index
+			if index < 0 {
+				index += len(array)
+			}
+			if index < 0 || index >= len(array) {
+				return nil, fmt.Errorf("index %d out of range", segment.index)
+			}
+			value = array[index]
+			continue
+		}
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("cannot get member %s of %T", segment.member, value)
+		}
+		if value, ok = object[segment.member]; !ok {
+			return nil, fmt.Errorf("missing member %s", segment.member)
+		}
+	}
+	if value == nil {
+		return nil, errors.New("value is null")
+	}
+	return value, nil
+}
+
+// ExtractString - returns the value at the path of the JSON document as a string. Strings are returned as is, other values
+// as their compact JSON encoding.
+func (p JsonPath) ExtractString(data []byte) (string, error) {
+	value, err := p.Extract(data)
+	if err != nil {
+		return "", err
+	}
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
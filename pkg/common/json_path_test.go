@@ -0,0 +1,58 @@
+package common
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseJsonPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		wantErr bool
+	}{
+		{path: "$"},
+		{path: "$.result"},
+		{path: "$.result.sync_info['latest_block_height']"},
+		{path: `$["result"][0].hash`},
+		{path: "$.result[-1]"},
+		{path: "result", wantErr: true},
+		{path: "$..result", wantErr: true},
+		{path: "$.result[0", wantErr: true},
+		{path: "$.result[*]", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			_, err := ParseJsonPath(tt.path)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestJsonPathExtractString(t *testing.T) {
+	document := `{"result":{"number":"0x10","height":18446744073709551615,"synced":true,"hashes":["0xa","0xb"],"header":{"hash":"0xc"},"empty":null}}`
+	tests := []struct {
+		path     string
+		expected string
+		wantErr  bool
+	}{
+		{path: "$.result.number", expected: "0x10"},
+		{path: "$.result.height", expected: "18446744073709551615"},
+		{path: "$.result.synced", expected: "true"},
+		{path: "$.result.hashes[0]", expected: "0xa"},
+		{path: "$.result.hashes[-1]", expected: "0xb"},
+		{path: "$.result['header']", expected: `{"hash":"0xc"}`},
+		{path: "$.result.hashes[2]", wantErr: true},
+		{path: "$.result.missing", wantErr: true},
+		{path: "$.result.empty", wantErr: true},
+		{path: "$.result.number.hash", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			jsonPath, err := ParseJsonPath(tt.path)
+			assert.Nil(t, err)
+			value, err := jsonPath.ExtractString([]byte(document))
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
@@ -56,7 +56,7 @@ func NewBasicClient(fullNodeHost string, userAgent string, timeout time.Duration
 //   - (error): Error, if any.
 func (r BasicClient) GetSession(req *models.GetSessionRequest) (*models.GetSessionResponse, error) {
 	var sessionResponse models.GetSessionResponse
-	err := r.makeRequest(endpointDispatch, "POST", req, &sessionResponse, nil, nil)
+	err := r.makeRequest(endpointDispatch, "POST", req, &sessionResponse, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +77,7 @@ func (r BasicClient) GetSession(req *models.GetSessionRequest) (*models.GetSessi
 func (r BasicClient) GetLatestStakedApplications() ([]*models.PoktApplication, error) {
 	reqParams := map[string]any{"opts": map[string]any{"per_page": maxApplications}}
 	var resp models.GetApplicationResponse
-	err := r.makeRequest(endpointGetApps, "POST", reqParams, &resp, nil, nil)
+	err := r.makeRequest(endpointGetApps, "POST", reqParams, &resp, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +127,11 @@ func (r BasicClient) SendRelay(req *models.SendRelayRequest) (*models.SendRelayR
 		Payload:    req.Payload,
 		Metadata:   relayMetadata,
 		RelayProof: relayProof,
-	}, &sessionResponse, &node.ServiceUrl, req.Timeout)
+	}, &sessionResponse, &node.ServiceUrl, req.Timeout, func(response *fasthttp.Response) {
+		// The node's status and headers are returned to the relay caller like an altruist's
+		sessionResponse.StatusCode = response.StatusCode()
+		sessionResponse.Headers = GetRelayResponseHeaders(&response.Header)
+	})
 
 	if err != nil {
 		return nil, err
@@ -143,7 +147,7 @@ func (r BasicClient) SendRelay(req *models.SendRelayRequest) (*models.SendRelayR
 func (r BasicClient) GetLatestBlockHeight() (*models.GetLatestBlockHeightResponse, error) {
 
 	var height models.GetLatestBlockHeightResponse
-	err := r.makeRequest(endpointGetHeight, "POST", nil, &height, nil, nil)
+	err := r.makeRequest(endpointGetHeight, "POST", nil, &height, nil, nil, nil)
 
 	if err != nil {
 		return nil, err
@@ -152,7 +156,9 @@ func (r BasicClient) GetLatestBlockHeight() (*models.GetLatestBlockHeightRespons
 	return &height, nil
 }
 
-func (r BasicClient) makeRequest(endpoint string, method string, requestData any, responseModel any, hostOverride *string, providedReqTimeout *time.Duration) error {
+// makeRequest - sends a request to the full node or the host override and decodes the response into responseModel.
+// onSuccess is called with the raw response of successful requests, once the response is decoded.
+func (r BasicClient) makeRequest(endpoint string, method string, requestData any, responseModel any, hostOverride *string, providedReqTimeout *time.Duration, onSuccess func(response *fasthttp.Response)) error {
 	reqPayload, err := ffjson.Marshal(requestData)
 	if err != nil {
 		return err
@@ -200,5 +206,11 @@ func (r BasicClient) makeRequest(endpoint string, method string, requestData any
 		}
 		return pocketError
 	}
-	return ffjson.Unmarshal(response.Body(), responseModel)
+	if err := ffjson.Unmarshal(response.Body(), responseModel); err != nil {
+		return err
+	}
+	if onSuccess != nil {
+		onSuccess(response)
+	}
+	return nil
 }
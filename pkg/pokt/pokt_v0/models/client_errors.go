@@ -7,4 +7,5 @@ var (
 	ErrSessionHasZeroNodes       = errors.New("session missing valid nodes")
 	ErrNodeNotFound              = errors.New("node not found")
 	ErrMalformedSendRelayRequest = errors.New("malformed send relay request")
+	ErrNoNodeAvailable           = errors.New("node selector can't find node")
 )
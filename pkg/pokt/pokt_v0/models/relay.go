@@ -13,6 +13,8 @@ type SendRelayRequest struct {
 	SelectedNodePubKey string
 	Session            *Session
 	Timeout            *time.Duration
+	// Archival restricts the relay to nodes that serve historical state.
+	Archival bool
 }
 
 func (req SendRelayRequest) Validate() error {
@@ -24,6 +26,12 @@ func (req SendRelayRequest) Validate() error {
 
 type SendRelayResponse struct {
 	Response string `json:"response"`
+	// StatusCode is the HTTP status code of the node or altruist. Zero is treated as 200.
+	StatusCode int `json:"-"`
+	// Headers are the allow-listed response headers of the node or altruist.
+	Headers map[string]string `json:"-"`
+	// Altruist is whether the relay was served by the altruist instead of the network.
+	Altruist bool `json:"-"`
 }
 
 // ffjson: skip
@@ -0,0 +1,23 @@
+package pokt_v0
+
+import "github.com/valyala/fasthttp"
+
+// relayResponseHeaders are the upstream response headers that are safe to return to the relay caller
+var relayResponseHeaders = []string{
+	fasthttp.HeaderContentType,
+	fasthttp.HeaderCacheControl,
+	fasthttp.HeaderETag,
+	fasthttp.HeaderLastModified,
+	fasthttp.HeaderRetryAfter,
+}
+
+// GetRelayResponseHeaders - returns the allow-listed headers of a node or altruist response.
+func GetRelayResponseHeaders(header *fasthttp.ResponseHeader) map[string]string {
+	headers := map[string]string{}
+	for _, name := range relayResponseHeaders {
+		if value := header.Peek(name); len(value) > 0 {
+			headers[name] = string(value)
+		}
+	}
+	return headers
+}
@@ -6,3 +6,8 @@ mockery --dir=./internal/chain_configurations_registry --name=ChainConfiguration
 mockery --dir=./internal/node_selector_service --name=NodeSelectorService --filename=node_selector_mock.go  --output=./mocks/node_selector --outpkg=node_selector_mock --with-expecter
 mockery --dir=./internal/apps_registry --name=AppsRegistryService --filename=app_registry_mock.go  --output=./mocks/apps_registry --outpkg=apps_registry_mock --with-expecter
 mockery --dir=./internal/global_config --name=GlobalConfigProvider --filename=config_provider.go  --output=./mocks/global_config --outpkg=global_config_mock --with-expecter
+mockery --dir=./internal/relay_usage_meter --name=RelayUsageMeterService --filename=relay_usage_meter_mock.go  --output=./mocks/relay_usage_meter --outpkg=relay_usage_meter_mock --with-expecter
+mockery --dir=./internal/altruist_registry --name=AltruistRegistryService --filename=altruist_registry_mock.go  --output=./mocks/altruist_registry --outpkg=altruist_registry_mock --with-expecter
+mockery --dir=./internal/altruist_circuit_breaker --name=CircuitBreakerService --filename=altruist_circuit_breaker_mock.go  --output=./mocks/altruist_circuit_breaker --outpkg=altruist_circuit_breaker_mock --with-expecter
+mockery --dir=./internal/chain_checks_registry --name=ChainChecksService --filename=chain_checks_registry_mock.go  --output=./mocks/chain_checks_registry --outpkg=chain_checks_registry_mock --with-expecter
+mockery --dir=./internal/db_query --name=Querier --filename=querier_mock.go  --output=./mocks/db_query --outpkg=db_query_mock --with-expecter